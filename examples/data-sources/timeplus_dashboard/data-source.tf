@@ -5,3 +5,8 @@ data "timeplus_dashboard" "example" {
 output "example_dashboard" {
   value = data.timeplus_dashboard.example
 }
+
+// look up the dashboard by its name instead of the ID
+data "timeplus_dashboard" "by_name" {
+  name = "example"
+}
@@ -0,0 +1,10 @@
+data "timeplus_dashboards" "ops" {
+  name_prefix = "ops "
+}
+
+// look up every dashboard to get its panels
+data "timeplus_dashboard" "ops" {
+  for_each = { for d in data.timeplus_dashboards.ops.dashboards : d.name => d.id }
+
+  id = each.value
+}
@@ -0,0 +1,7 @@
+data "timeplus_dictionary" "example" {
+  name = "products_dict"
+}
+
+output "example_dictionary" {
+  value = data.timeplus_dictionary.example
+}
@@ -0,0 +1,7 @@
+data "timeplus_functions" "javascript" {
+  type = "javascript"
+}
+
+output "javascript_functions" {
+  value = data.timeplus_functions.javascript.names
+}
@@ -0,0 +1,8 @@
+data "timeplus_lineage" "example" {
+  name      = "car_live_data"
+  recursive = true
+}
+
+output "affected_objects" {
+  value = [for obj in data.timeplus_lineage.example.downstream : "${obj.type} ${obj.name}"]
+}
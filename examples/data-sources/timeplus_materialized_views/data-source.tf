@@ -0,0 +1,5 @@
+data "timeplus_materialized_views" "all" {}
+
+output "materialized_views" {
+  value = { for mv in data.timeplus_materialized_views.all.materialized_views : mv.name => mv.description }
+}
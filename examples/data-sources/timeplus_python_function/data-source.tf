@@ -0,0 +1,7 @@
+data "timeplus_python_function" "example" {
+  name = "add_five"
+}
+
+output "example_python_func" {
+  value = data.timeplus_python_function.example
+}
@@ -11,3 +11,8 @@ output "example_sink" {
     query       = data.timeplus_sink.example.query
   }
 }
+
+// look up the sink by its name instead of the ID
+data "timeplus_sink" "by_name" {
+  name = "Hot Cities"
+}
@@ -0,0 +1,7 @@
+data "timeplus_sinks" "kafka" {
+  type = "kafka"
+}
+
+output "kafka_sink_ids" {
+  value = [for s in data.timeplus_sinks.kafka.sinks : s.id]
+}
@@ -11,3 +11,8 @@ output "example_source" {
     type        = data.timeplus_source.example.type
   }
 }
+
+// look up the source by a regular expression, it must match exactly one source
+data "timeplus_source" "by_name_regex" {
+  name_regex = "^kafka example"
+}
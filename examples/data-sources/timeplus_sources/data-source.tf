@@ -0,0 +1,9 @@
+data "timeplus_sources" "production" {
+  labels = {
+    env = "production"
+  }
+}
+
+output "production_sources" {
+  value = data.timeplus_sources.production.sources
+}
@@ -0,0 +1,16 @@
+// all versioned_kv streams owned by the analytics team whose names start with `dim_`
+data "timeplus_streams" "dimensions" {
+  name_prefix = "dim_"
+  mode        = "versioned_kv"
+  labels = {
+    team = "analytics"
+  }
+}
+
+// e.g. a materialized view per dimension stream
+resource "timeplus_materialized_view" "dimension_changes" {
+  for_each = toset(data.timeplus_streams.dimensions.names)
+
+  name  = "${each.key}_changes"
+  query = "select * from ${each.key}"
+}
@@ -0,0 +1,7 @@
+data "timeplus_views" "reports" {
+  name_regex = "^report_.*_daily$"
+}
+
+output "report_views" {
+  value = data.timeplus_views.reports.names
+}
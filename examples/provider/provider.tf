@@ -10,4 +10,7 @@ terraform {
 provider "timeplus" {
   username = "my-username"
   password = "my-password"
+
+  # optional, report invalid queries during `terraform plan`
+  validate_sql_on_plan = true
 }
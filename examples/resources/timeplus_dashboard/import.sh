@@ -0,0 +1,3 @@
+# Dashboards can be imported by ID, or by name in the form of `name:<name>`
+terraform import timeplus_dashboard.example 7f10df2d-6ad4-4dea-9954-3d8934b2c329
+terraform import timeplus_dashboard.example "name:example"
@@ -64,3 +64,36 @@ resource "timeplus_dashboard" "example" {
 ]
 JSON
 }
+
+resource "timeplus_dashboard" "nested" {
+  name        = "nested"
+  description = "The same dashboard with the panels defined in the `panel` attribute."
+
+  panel = [
+    {
+      title      = "Title"
+      position   = { h = 1, nextX = 12, nextY = 2, w = 12, x = 0, y = 1 }
+      viz_type   = "markdown"
+      viz_config = jsonencode({ mdString = "Error Monitoring" })
+    },
+    {
+      title    = "App Name"
+      position = { h = 1, nextX = 3, nextY = 1, w = 3, x = 0, y = 0 }
+      viz_type = "control"
+      viz_config = jsonencode({
+        chartType    = "text"
+        defaultValue = "my_app"
+        inlineValues = ""
+        label        = "App Name"
+        target       = "app_name"
+      })
+    },
+    {
+      title       = "Error count in last 30 min"
+      position    = { h = 4, nextX = 6, nextY = 6, w = 6, x = 0, y = 2 }
+      viz_type    = "chart"
+      viz_content = "select window_start, window_end, sum(value) from tumble(metrics, 30m) where name = 'error' group by window_start, window_end"
+      viz_config  = jsonencode({ chartType = "line" })
+    },
+  ]
+}
@@ -0,0 +1,75 @@
+resource "timeplus_mutable_stream" "products" {
+  name = "products"
+
+  column {
+    name = "id"
+    type = "string"
+  }
+
+  column {
+    name = "name"
+    type = "string"
+  }
+
+  column {
+    name = "price"
+    type = "float64"
+  }
+
+  primary_key = ["id"]
+}
+
+resource "timeplus_dictionary" "stream_example" {
+  name        = "products_dict"
+  description = "Look up product names and prices by product id"
+
+  key {
+    name = "id"
+    type = "string"
+  }
+
+  attribute {
+    name    = "name"
+    type    = "string"
+    default = "unknown"
+  }
+
+  attribute {
+    name = "price"
+    type = "float64"
+  }
+
+  source {
+    type   = "mutable_stream"
+    stream = timeplus_mutable_stream.products.name
+  }
+
+  layout = "complex_key_hashed"
+}
+
+resource "timeplus_dictionary" "http_example" {
+  name = "countries_dict"
+
+  key {
+    name = "code"
+    type = "uint64"
+  }
+
+  attribute {
+    name = "country"
+    type = "string"
+  }
+
+  source {
+    type = "http"
+
+    http {
+      url    = "https://example.com/countries.csv"
+      format = "CSVWithNames"
+    }
+  }
+
+  layout       = "flat"
+  lifetime_min = 3600
+  lifetime_max = 7200
+}
@@ -0,0 +1,2 @@
+# External tables can be imported by name, the password needs to be set in the configuration after the import
+terraform import timeplus_external_table.example ch_customers
@@ -0,0 +1,31 @@
+variable "clickhouse_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "timeplus_external_table" "clickhouse_example" {
+  name        = "ch_customers"
+  description = "The customers table in ClickHouse"
+  type        = "clickhouse"
+
+  clickhouse {
+    address  = "clickhouse.example.com:9440"
+    database = "crm"
+    table    = "customers"
+    user     = "timeplus"
+    password = var.clickhouse_password
+    secure   = true
+  }
+}
+
+resource "timeplus_external_table" "postgresql_example" {
+  name = "pg_products"
+  type = "postgresql"
+
+  postgresql {
+    address  = "postgres.example.com:5432"
+    database = "shop"
+    table    = "products"
+    user     = "readonly"
+  }
+}
@@ -0,0 +1,43 @@
+resource "timeplus_format_schema" "example" {
+  name = "temperature"
+  type = "protobuf"
+  body = <<-EOT
+    syntax = "proto3";
+
+    message Temperature {
+      string city_name = 1;
+      float temp = 2;
+    }
+  EOT
+}
+
+resource "timeplus_stream" "example" {
+  name = "city_temperature_proto"
+
+  column {
+    name = "city_name"
+    type = "string"
+  }
+
+  column {
+    name = "temp"
+    type = "float32"
+  }
+}
+
+resource "timeplus_sink" "example" {
+  name  = "Temperature to Kafka"
+  query = "select city_name, temp from ${timeplus_stream.example.name}"
+  type  = "kafka"
+  properties = jsonencode({
+    brokers     = "localhost:9092"
+    topic       = "temperature"
+    data_format = "ProtobufSingle"
+    # referencing the format schema makes sure it's created before the sink
+    format_schema = "${timeplus_format_schema.example.name}:Temperature"
+  })
+
+  lifecycle {
+    replace_triggered_by = [timeplus_format_schema.example.hash]
+  }
+}
@@ -19,6 +19,12 @@ resource "timeplus_javascript_function" "example" {
     return left.map((n, i) => [n, right[i]]).map(ins => ins[0] * ins[0] + ins[1] * ins[1])
   }
   EOS
+
+  # the tests are run when the plan is made
+  test {
+    args     = jsonencode([3, 4])
+    expected = jsonencode(25)
+  }
 }
 
 resource "timeplus_javascript_function" "aggregate_example" {
@@ -77,4 +83,9 @@ resource "timeplus_javascript_function" "aggregate_example" {
     }
 };
   EOS
+
+  test {
+    rows     = jsonencode([[1.5], [3], [2], [3]])
+    expected = jsonencode(2)
+  }
 }
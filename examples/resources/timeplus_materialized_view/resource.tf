@@ -36,3 +36,17 @@ resource "timeplus_materialized_view" "retention_example" {
   retention_ms    = 7 * 24 * 60 * 60 * 1000 // 7 days in ms 
   history_ttl     = "to_datetime(_tp_time) + INTERVAL 30 DAY"
 }
+
+resource "timeplus_materialized_view" "settings_example" {
+  name        = "speeding_vehcles_backfill"
+  description = "A materialized view reads from the earliest data, it can be paused by setting `paused` to true"
+  query       = <<-SQL
+  select * from ${resource.timeplus_stream.traffic.name} where speed_mph > road_speed_limit_mph
+  SQL
+  paused      = false
+
+  settings {
+    checkpoint_interval = 60
+    seek_to             = "earliest"
+  }
+}
@@ -0,0 +1,45 @@
+resource "timeplus_mutable_stream" "example" {
+  name = "products"
+
+  description = "A mutable stream works as a dimension table"
+
+  column {
+    name = "id"
+    type = "string"
+  }
+
+  column {
+    name = "name"
+    type = "string"
+  }
+
+  column {
+    name = "category"
+    type = "string"
+  }
+
+  column {
+    name = "price"
+    type = "float64"
+  }
+
+  column {
+    name = "updated_at"
+    type = "datetime64(3)"
+  }
+
+  primary_key    = ["id"]
+  version_column = "updated_at"
+
+  index {
+    name    = "idx_category"
+    columns = ["category"]
+  }
+
+  family {
+    name    = "pricing"
+    columns = ["price", "updated_at"]
+  }
+
+  shards = 3
+}
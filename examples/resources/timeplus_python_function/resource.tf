@@ -0,0 +1,44 @@
+resource "timeplus_python_function" "example" {
+  name        = "add_five"
+  description = "Adds five to the value"
+
+  return_type = "float64"
+
+  arg {
+    name = "value"
+    type = "float64"
+  }
+
+  source = <<-EOS
+  def add_five(value):
+      for i in range(len(value)):
+          value[i] = value[i] + 5
+      return value
+  EOS
+}
+
+resource "timeplus_python_function" "with_requirements" {
+  name        = "zscore"
+  description = "Returns the z-score of the values in each batch"
+
+  return_type = "float64"
+
+  arg {
+    name = "value"
+    type = "float64"
+  }
+
+  # the packages are installed by Timeplus
+  requirements = ["numpy==1.26.4"]
+
+  source = <<-EOS
+  import numpy as np
+
+  def zscore(value):
+      a = np.array(value)
+      std = a.std()
+      if std == 0:
+          return [0.0] * len(value)
+      return ((a - a.mean()) / std).tolist()
+  EOS
+}
@@ -0,0 +1,32 @@
+resource "timeplus_random_stream" "example" {
+  name = "random_orders"
+
+  description = "Generates 100 fake orders per second"
+
+  eps = 100
+
+  column {
+    name    = "order_id"
+    type    = "string"
+    default = "uuid()"
+  }
+
+  column {
+    name    = "product"
+    type    = "string"
+    default = "['apple', 'banana', 'cherry'][rand() % 3 + 1]"
+  }
+
+  column {
+    name    = "quantity"
+    type    = "uint16"
+    default = "rand() % 10 + 1"
+  }
+
+  column {
+    name              = "created_at"
+    type              = "datetime64(3)"
+    default           = "now64(3)"
+    use_as_event_time = true
+  }
+}
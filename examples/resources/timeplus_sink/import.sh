@@ -0,0 +1,5 @@
+# Sinks can be imported by ID, or by name in the form of `name:<name>`. The typed block of the sink type (e.g. `kafka`) is filled
+# when the type has one, otherwise `properties` is filled. Secrets are not returned by Timeplus, they need to be set in the
+# configuration and applied after the import, or be ignored with `lifecycle { ignore_changes = [...] }`
+terraform import timeplus_sink.example 7f10df2d-6ad4-4dea-9954-3d8934b2c329
+terraform import timeplus_sink.example "name:Hot Cities"
@@ -26,6 +26,42 @@ resource "timeplus_sink" "example" {
   })
 }
 
+resource "timeplus_sink" "kafka_example" {
+  name        = "Hot Cities to Kafka"
+  description = "An example sink sends data to a Kafka topic, configured with the typed block instead of properties."
+  query       = "select _tp_time, city_name, temp from ${timeplus_stream.example.name} where temp > 30"
+
+  # fails the apply if the sink can't connect to Kafka, e.g. because of a wrong password
+  fail_on_unhealthy = true
+
+  kafka {
+    brokers     = "kafka1:9092,kafka2:9092"
+    topic       = "hot_cities"
+    data_format = "JSONEachRow"
+    sasl        = "plain"
+    username    = "timeplus"
+    password    = var.kafka_password
+  }
+}
+
+resource "timeplus_sink" "map_example" {
+  name        = "Hot Cities via properties_map"
+  description = "An example sink configured with a map instead of a JSON string, non-string values are decoded from JSON."
+  query       = "select _tp_time, city_name, temp from ${timeplus_stream.example.name}"
+  type        = "http"
+  properties_map = {
+    url                 = "http://localhost:6789"
+    http_method         = "POST"
+    content_type        = "application/json"
+    skip_ssl_cert_check = true
+  }
+}
+
+variable "kafka_password" {
+  type      = string
+  sensitive = true
+}
+
 output "example_sink_id" {
   value = timeplus_sink.example.id
 }
@@ -53,3 +53,23 @@ resource "timeplus_source" "secure_kafka_example" {
     data_type = "json"
   })
 }
+
+resource "timeplus_source" "typed_kafka_example" {
+  name        = "typed kafka example"
+  description = "A source example configured with the typed block, it fails to be created if no messages can be read"
+  stream      = timeplus_stream.example.name
+
+  # reads a few messages before creating the source, to catch wrong broker addresses or data formats early
+  preview_on_create = true
+
+  kafka {
+    brokers     = "127.0.0.1:19092"
+    topic       = "some-topic"
+    offset      = "latest"
+    data_type   = "json"
+    sasl        = "plain"
+    username    = "some-username"
+    password    = var.password
+    tls_disable = false
+  }
+}
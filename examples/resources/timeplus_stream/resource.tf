@@ -3,6 +3,9 @@ resource "timeplus_stream" "basic_example" {
 
   description = "A simple stream with three columns"
 
+  # delete the views, materialized views and sinks use the stream when it's deleted or recreated
+  cascade_delete = true
+
   column {
     name = "col_1"
     type = "string"
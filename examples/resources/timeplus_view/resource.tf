@@ -22,3 +22,16 @@ resource "timeplus_view" "example" {
   name  = "speeding_vehcles"
   query = "select * from ${resource.timeplus_stream.traffic.name} where speed_mph > road_speed_limit_mph"
 }
+
+resource "timeplus_view" "parameterized_example" {
+  name  = "vehicles_faster_than"
+  query = "select * from ${resource.timeplus_stream.traffic.name} where speed_mph > {{min_speed:uint8}}"
+
+  parameters = [
+    {
+      name    = "min_speed"
+      type    = "uint8"
+      default = "80"
+    },
+  ]
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package jsudf
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dop251/goja/ast"
+	"github.com/dop251/goja/file"
+	"github.com/dop251/goja/parser"
+)
+
+// aggregateMethods are the lifecycle methods an aggregate function must define, and the number of arguments each of
+// them takes. `process` takes one argument per argument of the function, it's -1 here.
+var aggregateMethods = []struct {
+	name string
+	argc int
+}{
+	{name: "initialize", argc: 0},
+	{name: "process", argc: -1},
+	{name: "finalize", argc: 0},
+	{name: "serialize", argc: 0},
+	{name: "deserialize", argc: 1},
+	{name: "merge", argc: 1},
+}
+
+// CheckAggregate statically checks the source of an aggregate function which takes `argc` arguments, without running
+// it. It returns the violations of the contract of aggregate functions: missing or mis-named lifecycle methods,
+// lifecycle methods which are not functions, and lifecycle methods taking a wrong number of arguments. A
+// *SyntaxError is returned when the source can't be parsed.
+func CheckAggregate(source string, argc int) ([]string, error) {
+	fs := &file.FileSet{}
+	// the source is an object literal, it's parsed as an expression
+	prog, err := parser.ParseFile(fs, "aggregate", "("+strings.TrimRight(source, " \t\r\n;")+"\n)", 0)
+	if err != nil {
+		return nil, &SyntaxError{Message: err.Error()}
+	}
+
+	var obj *ast.ObjectLiteral
+	if len(prog.Body) == 1 {
+		if stmt, ok := prog.Body[0].(*ast.ExpressionStatement); ok {
+			obj, _ = stmt.Expression.(*ast.ObjectLiteral)
+		}
+	}
+	if obj == nil {
+		return []string{"The source of an aggregate function must be an object literal defining the lifecycle methods, e.g. `{ initialize: function() { ... }, ... }`."}, nil
+	}
+
+	// the methods defined by the object, keyed by name
+	defined := make(map[string]*ast.PropertyKeyed, len(obj.Value))
+	var names []string
+	for _, p := range obj.Value {
+		prop, ok := p.(*ast.PropertyKeyed)
+		if !ok || prop.Computed {
+			continue
+		}
+		key, ok := prop.Key.(*ast.StringLiteral)
+		if !ok {
+			continue
+		}
+		defined[key.Value.String()] = prop
+		names = append(names, key.Value.String())
+	}
+
+	var problems []string
+	for _, m := range aggregateMethods {
+		want := m.argc
+		if want < 0 {
+			want = argc
+		}
+
+		prop, ok := defined[m.name]
+		if !ok {
+			msg := fmt.Sprintf("The lifecycle method `%s` is missing.", m.name)
+			if similar := similarName(m.name, names); similar != "" {
+				msg = fmt.Sprintf("The lifecycle method `%s` is missing, `%s` is defined instead, is it mis-named?", m.name, similar)
+			}
+			problems = append(problems, msg)
+			continue
+		}
+
+		pos := fs.Position(prop.Idx0())
+		var params *ast.ParameterList
+		switch fn := prop.Value.(type) {
+		case *ast.FunctionLiteral:
+			params = fn.ParameterList
+		case *ast.ArrowFunctionLiteral:
+			problems = append(problems, fmt.Sprintf("Line %d: the lifecycle method `%s` is an arrow function, it must be a regular function to access the state via `this`.", pos.Line, m.name))
+			continue
+		default:
+			problems = append(problems, fmt.Sprintf("Line %d: the lifecycle method `%s` must be a function.", pos.Line, m.name))
+			continue
+		}
+
+		got := len(params.List)
+		if params.Rest != nil && got <= want {
+			continue
+		}
+		if got != want {
+			problems = append(problems, fmt.Sprintf("Line %d: the lifecycle method `%s` must take %s, but it takes %d.", pos.Line, m.name, plural(want, "argument"), got))
+		}
+	}
+
+	return problems, nil
+}
+
+// similarName returns the name in `names` which is most likely a misspelling of `name`, e.g. `Initialize` or
+// `finalise` for `finalize`. An empty string is returned if there isn't one.
+func similarName(name string, names []string) string {
+	best, bestDist := "", 3
+	for _, n := range names {
+		if n == name {
+			continue
+		}
+		if d := editDistance(strings.ToLower(n), strings.ToLower(name)); d < bestDist {
+			best, bestDist = n, d
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance of `a` and `b`
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: MPL-2.0
+
+// Package jsudf runs Timeplus javascript functions locally with an embedded javascript engine, so that they can be
+// tested without a Timeplus server.
+//
+// Timeplus calls javascript functions with columns rather than single values: each argument is an array holding the
+// values of a batch of rows. A plain function returns an array with one value per row. An aggregate function is an
+// object whose `process` method is called with the columns, and whose `finalize` method returns the aggregated value.
+// CheckAggregate checks the lifecycle methods of aggregate functions statically, without running them.
+package jsudf
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dop251/goja"
+)
+
+// Timeout limits how long a function runs, so that an endless loop in the source doesn't hang the caller.
+const Timeout = 5 * time.Second
+
+// SyntaxError is returned when the source can't be compiled.
+type SyntaxError struct {
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return e.Message
+}
+
+// Call calls the plain function `name` defined in `source` with the arguments of one row, and returns the value the
+// function returns for the row.
+func Call(name, source string, args []any) (any, error) {
+	prog, err := compile(name, source)
+	if err != nil {
+		return nil, err
+	}
+
+	vm := goja.New()
+	res, err := run(vm, func() (goja.Value, error) {
+		if _, err := vm.RunProgram(prog); err != nil {
+			return nil, err
+		}
+
+		fn, ok := goja.AssertFunction(vm.Get(name))
+		if !ok {
+			return nil, fmt.Errorf("the source does not define the function %q", name)
+		}
+
+		cols := make([]goja.Value, 0, len(args))
+		for _, a := range args {
+			cols = append(cols, vm.NewArray(a))
+		}
+		return fn(goja.Undefined(), cols...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	values, ok := res.Export().([]any)
+	if !ok || len(values) != 1 {
+		return nil, fmt.Errorf("the function must return an array with one value per row, got %s", res.String())
+	}
+	return values[0], nil
+}
+
+// Aggregate feeds the rows to the aggregate function defined in `source`, each row holds the arguments of the
+// function. It returns the value returned by `finalize`.
+func Aggregate(source string, rows [][]any) (any, error) {
+	// the source is an object literal, it's evaluated as an expression
+	expr := "(" + strings.TrimRight(source, " \t\r\n;") + "\n)"
+	prog, err := compile("aggregate", expr)
+	if err != nil {
+		return nil, err
+	}
+
+	vm := goja.New()
+	res, err := run(vm, func() (goja.Value, error) {
+		v, err := vm.RunProgram(prog)
+		if err != nil {
+			return nil, err
+		}
+
+		obj, ok := v.(*goja.Object)
+		if !ok {
+			return nil, errors.New("the source of an aggregate function must be an object")
+		}
+
+		method := func(name string) (goja.Callable, error) {
+			fn, ok := goja.AssertFunction(obj.Get(name))
+			if !ok {
+				return nil, fmt.Errorf("the aggregate function does not define the method %q", name)
+			}
+			return fn, nil
+		}
+
+		initialize, err := method("initialize")
+		if err != nil {
+			return nil, err
+		}
+		process, err := method("process")
+		if err != nil {
+			return nil, err
+		}
+		finalize, err := method("finalize")
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := initialize(obj); err != nil {
+			return nil, err
+		}
+		if len(rows) > 0 {
+			if _, err := process(obj, columns(vm, rows)...); err != nil {
+				return nil, err
+			}
+		}
+		return finalize(obj)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Export(), nil
+}
+
+// columns transposes the rows to the columns the functions are called with
+func columns(vm *goja.Runtime, rows [][]any) []goja.Value {
+	cols := make([][]any, len(rows[0]))
+	for _, row := range rows {
+		for i := range cols {
+			var v any
+			if i < len(row) {
+				v = row[i]
+			}
+			cols[i] = append(cols[i], v)
+		}
+	}
+
+	values := make([]goja.Value, 0, len(cols))
+	for _, c := range cols {
+		values = append(values, vm.NewArray(c...))
+	}
+	return values
+}
+
+func compile(name, source string) (*goja.Program, error) {
+	prog, err := goja.Compile(name, source, false)
+	if err != nil {
+		var syntaxErr *goja.CompilerSyntaxError
+		if errors.As(err, &syntaxErr) {
+			return nil, &SyntaxError{Message: syntaxErr.Error()}
+		}
+		return nil, err
+	}
+	return prog, nil
+}
+
+// run runs `fn` and interrupts it when it takes longer than Timeout
+func run(vm *goja.Runtime, fn func() (goja.Value, error)) (goja.Value, error) {
+	timer := time.AfterFunc(Timeout, func() {
+		vm.Interrupt(fmt.Sprintf("the function did not finish within %s", Timeout))
+	})
+	defer timer.Stop()
+
+	v, err := fn()
+	if err != nil {
+		var ex *goja.Exception
+		if errors.As(err, &ex) {
+			return nil, errors.New(ex.Value().String())
+		}
+		var interrupted *goja.InterruptedError
+		if errors.As(err, &interrupted) {
+			return nil, fmt.Errorf("%v", interrupted.Value())
+		}
+		return nil, err
+	}
+	return v, nil
+}
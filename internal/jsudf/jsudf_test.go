@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package jsudf
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+const secondMax = `
+{
+    initialize: function() {
+        this.max = -Infinity;
+        this.sec_max = -Infinity;
+    },
+
+    process: function(values) {
+        for (const v of values) {
+            if (v > this.max) {
+                this.sec_max = this.max;
+                this.max = v;
+            } else if (v < this.max && v > this.sec_max) {
+                this.sec_max = v;
+            }
+        }
+    },
+
+    finalize: function() {
+        return this.sec_max
+    }
+};
+`
+
+func TestCall(t *testing.T) {
+	cases := []struct {
+		name    string
+		source  string
+		args    []any
+		want    any
+		wantErr string
+	}{
+		{
+			name:   "map",
+			source: "function add(left, right) { return left.map((n, i) => n + right[i]) }",
+			args:   []any{1, 2},
+			want:   int64(3),
+		},
+		{
+			name:   "in place",
+			source: "function add(v, w) { for (let i = 0; i < v.length; i++) { v[i] = v[i] + w[i] }; return v }",
+			args:   []any{1.5, 2},
+			want:   3.5,
+		},
+		{
+			name:    "syntax error",
+			source:  "function add(left, right) { return left.map(",
+			args:    []any{1, 2},
+			wantErr: "syntax",
+		},
+		{
+			name:    "undefined function",
+			source:  "function plus(left, right) { return left }",
+			args:    []any{1, 2},
+			wantErr: `does not define the function "add"`,
+		},
+		{
+			name:    "not an array",
+			source:  "function add(left, right) { return left[0] + right[0] }",
+			args:    []any{1, 2},
+			wantErr: "must return an array",
+		},
+		{
+			name:    "exception",
+			source:  "function add(left, right) { throw new Error('boom') }",
+			args:    []any{1, 2},
+			wantErr: "boom",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := Call("add", c.source, c.args)
+			if c.wantErr != "" {
+				if err == nil || !strings.Contains(strings.ToLower(err.Error()), c.wantErr) {
+					t.Fatalf("Call() error = %v, want containing %q", err, c.wantErr)
+				}
+				if c.wantErr == "syntax" {
+					var syntaxErr *SyntaxError
+					if !errors.As(err, &syntaxErr) {
+						t.Fatalf("Call() error = %T, want *SyntaxError", err)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Call() error = %v", err)
+			}
+			if got != c.want {
+				t.Errorf("Call() = %#v, want %#v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	got, err := Aggregate(secondMax, [][]any{{1.0}, {3.0}, {2.0}, {3.0}})
+	if err != nil {
+		t.Fatalf("Aggregate() error = %v", err)
+	}
+	if got != int64(2) {
+		t.Errorf("Aggregate() = %#v, want 2", got)
+	}
+
+	if _, err := Aggregate("{ initialize: function() {} }", nil); err == nil || !strings.Contains(err.Error(), `"process"`) {
+		t.Errorf("Aggregate() error = %v, want missing process", err)
+	}
+
+	var syntaxErr *SyntaxError
+	if _, err := Aggregate("{ initialize: function() { }", nil); !errors.As(err, &syntaxErr) {
+		t.Errorf("Aggregate() error = %v, want *SyntaxError", err)
+	}
+}
+
+func TestCheckAggregate(t *testing.T) {
+	const full = `{
+    initialize: function() { this.sum = 0 },
+    process(values, weights) { for (let i = 0; i < values.length; i++) this.sum += values[i] * weights[i] },
+    finalize: function() { return this.sum },
+    serialize: function() { return JSON.stringify(this.sum) },
+    deserialize: function(s) { this.sum = JSON.parse(s) },
+    merge: function(s) { this.sum += JSON.parse(s) }
+};`
+
+	cases := []struct {
+		name   string
+		source string
+		argc   int
+		want   []string
+	}{
+		{
+			name:   "valid",
+			source: full,
+			argc:   2,
+		},
+		{
+			name:   "process argument count",
+			source: full,
+			argc:   1,
+			want:   []string{"Line 3: the lifecycle method `process` must take 1 argument, but it takes 2."},
+		},
+		{
+			name:   "rest arguments",
+			source: strings.Replace(full, "process(values, weights)", "process(...cols)", 1),
+			argc:   3,
+		},
+		{
+			name:   "mis-named",
+			source: strings.Replace(full, "finalize:", "finalise:", 1),
+			argc:   2,
+			want:   []string{"The lifecycle method `finalize` is missing, `finalise` is defined instead, is it mis-named?"},
+		},
+		{
+			name:   "missing",
+			source: strings.Replace(full, "merge:", "combine:", 1),
+			argc:   2,
+			want:   []string{"The lifecycle method `merge` is missing."},
+		},
+		{
+			name:   "arrow function",
+			source: strings.Replace(full, "initialize: function() { this.sum = 0 }", "initialize: () => {}", 1),
+			argc:   2,
+			want:   []string{"Line 2: the lifecycle method `initialize` is an arrow function, it must be a regular function to access the state via `this`."},
+		},
+		{
+			name:   "not an object",
+			source: "function sum(values) { return values }",
+			argc:   1,
+			want:   []string{"The source of an aggregate function must be an object literal defining the lifecycle methods, e.g. `{ initialize: function() { ... }, ... }`."},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := CheckAggregate(c.source, c.argc)
+			if err != nil {
+				t.Fatalf("CheckAggregate() error = %v", err)
+			}
+			if strings.Join(got, "\n") != strings.Join(c.want, "\n") {
+				t.Errorf("CheckAggregate() = %q, want %q", got, c.want)
+			}
+		})
+	}
+
+	var syntaxErr *SyntaxError
+	if _, err := CheckAggregate("{ initialize: function( }", 0); !errors.As(err, &syntaxErr) {
+		t.Errorf("CheckAggregate() error = %v, want *SyntaxError", err)
+	}
+}
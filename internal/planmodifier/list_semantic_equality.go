@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package planmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// plan modifier which keeps the list in state when the planned list is semantically the same
+type listSemanticEquality struct {
+	description string
+	equal       func(ctx context.Context, state, plan types.List) bool
+}
+
+// ListSemanticEquality is SemanticEquality for lists. The planned list may contain unknown values, it's up to `equal`
+// to decide how to compare them with the values in state.
+func ListSemanticEquality(description string, equal func(ctx context.Context, state, plan types.List) bool) planmodifier.List {
+	return listSemanticEquality{
+		description: description,
+		equal:       equal,
+	}
+}
+
+// Description implements planmodifier.List
+func (m listSemanticEquality) Description(_ context.Context) string {
+	return m.description
+}
+
+// MarkdownDescription implements planmodifier.List
+func (m listSemanticEquality) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyList implements planmodifier.List
+func (m listSemanticEquality) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	if m.equal(ctx, req.StateValue, req.PlanValue) {
+		resp.PlanValue = req.StateValue
+	}
+}
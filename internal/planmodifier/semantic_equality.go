@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package planmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/sqlnorm"
+)
+
+// plan modifier which keeps the value in state when the planned value is semantically the same
+type semanticEquality struct {
+	description string
+	equal       func(a, b string) bool
+}
+
+// SemanticEquality returns a plan modifier which uses the value in state, when `equal` reports it's the same as the planned value.
+// It prevents diffs caused by formatting changes either in the configuration or by the server.
+func SemanticEquality(description string, equal func(a, b string) bool) planmodifier.String {
+	return semanticEquality{
+		description: description,
+		equal:       equal,
+	}
+}
+
+// SemanticSQL returns a plan modifier which ignores changes that do not change the meaning of the SQL query, like
+// whitespaces, comments, keyword case and identifier quoting.
+func SemanticSQL() planmodifier.String {
+	return SemanticEquality("Ignores SQL formatting changes, like whitespaces, comments, keyword case and identifier quoting.", sqlnorm.Equal)
+}
+
+// Description implements planmodifier.String
+func (m semanticEquality) Description(_ context.Context) string {
+	return m.description
+}
+
+// MarkdownDescription implements planmodifier.String
+func (m semanticEquality) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyString implements planmodifier.String
+func (m semanticEquality) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	if m.equal(req.StateValue.ValueString(), req.PlanValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
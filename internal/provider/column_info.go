@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/timeplus"
+)
+
+// columnInfoAttrTypes are the attribute types of the read-only column lists, e.g. `columns` of views
+var columnInfoAttrTypes = map[string]attr.Type{
+	"name": types.StringType,
+	"type": types.StringType,
+}
+
+// columnInfoListAttribute returns the schema of a read-only column list. The value is kept from the state during
+// planning, resources need to set it to unknown when the columns are going to change, e.g. when the query changes.
+func columnInfoListAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.UseStateForUnknown(),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					MarkdownDescription: "The column name",
+					Computed:            true,
+				},
+				"type": schema.StringAttribute{
+					MarkdownDescription: "The type name of the column",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+// columnInfoListDataSourceAttribute is columnInfoListAttribute for data sources.
+func columnInfoListDataSourceAttribute(description string) datasourceschema.ListNestedAttribute {
+	return datasourceschema.ListNestedAttribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		NestedObject: datasourceschema.NestedAttributeObject{
+			Attributes: map[string]datasourceschema.Attribute{
+				"name": datasourceschema.StringAttribute{
+					MarkdownDescription: "The column name",
+					Computed:            true,
+				},
+				"type": datasourceschema.StringAttribute{
+					MarkdownDescription: "The type name of the column",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func columnInfoListValue(columns []timeplus.Column) (types.List, diag.Diagnostics) {
+	elems := make([]attr.Value, 0, len(columns))
+	for _, col := range columns {
+		elems = append(elems, types.ObjectValueMust(columnInfoAttrTypes, map[string]attr.Value{
+			"name": types.StringValue(col.Name),
+			"type": types.StringValue(col.Type),
+		}))
+	}
+	return types.ListValue(types.ObjectType{AttrTypes: columnInfoAttrTypes}, elems)
+}
+
+// columnInfoListUnknown is used in plans when the columns are going to change
+func columnInfoListUnknown() types.List {
+	return types.ListUnknown(types.ObjectType{AttrTypes: columnInfoAttrTypes})
+}
+
+// columnTypeAliases maps the aliases of the data types to their canonical names
+var columnTypeAliases = map[string]string{
+	"int":       "int32",
+	"integer":   "int32",
+	"uint":      "uint32",
+	"tinyint":   "int8",
+	"smallint":  "int16",
+	"bigint":    "int64",
+	"float":     "float32",
+	"real":      "float32",
+	"double":    "float64",
+	"boolean":   "bool",
+	"text":      "string",
+	"varchar":   "string",
+	"char":      "string",
+	"timestamp": "datetime",
+}
+
+var columnTypeWord = regexp.MustCompile(`[a-z_][a-z0-9_]*`)
+
+// normalizeColumnType returns the canonical form of the type name, so that type names which only differ in cases,
+// whitespaces or aliases are the same, e.g. `Nullable(INT)` and `nullable(int32)`.
+func normalizeColumnType(typ string) string {
+	typ = strings.ToLower(strings.Join(strings.Fields(typ), ""))
+	return columnTypeWord.ReplaceAllStringFunc(typ, func(w string) string {
+		if alias, ok := columnTypeAliases[w]; ok {
+			return alias
+		}
+		return w
+	})
+}
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/timeplus"
+)
+
+func TestNormalizeColumnType(t *testing.T) {
+	cases := []struct {
+		a, b string
+		same bool
+	}{
+		{a: "int", b: "int32", same: true},
+		{a: "Nullable(INT)", b: "nullable(int32)", same: true},
+		{a: "decimal(10, 2)", b: "decimal(10,2)", same: true},
+		{a: "array(double)", b: "array(float64)", same: true},
+		{a: "map(string, bigint)", b: "map(text,int64)", same: true},
+		{a: "int", b: "int64", same: false},
+		{a: "float", b: "float64", same: false},
+		{a: "string", b: "low_cardinality(string)", same: false},
+	}
+
+	for _, c := range cases {
+		if got := normalizeColumnType(c.a) == normalizeColumnType(c.b); got != c.same {
+			t.Errorf("%q and %q: same = %v, want %v", c.a, c.b, got, c.same)
+		}
+	}
+}
+
+func TestTargetSchemaMismatches(t *testing.T) {
+	output := []timeplus.Column{{Name: "a", Type: "int"}, {Name: "b", Type: "string"}, {Name: "c", Type: "float64"}}
+	target := []timeplus.Column{{Name: "a", Type: "int32"}, {Name: "b", Type: "string"}, {Name: "c", Type: "float32"}, {Name: "d", Type: "bool"}}
+
+	got := targetSchemaMismatches(output, target)
+	want := []string{`column "c" is float64 in the query output, but float32 in the target stream`}
+	if len(got) != len(want) || got[0] != want[0] {
+		t.Errorf("targetSchemaMismatches() = %q, want %q", got, want)
+	}
+
+	if got := targetSchemaMismatches([]timeplus.Column{{Name: "x", Type: "int"}}, target); len(got) != 1 {
+		t.Errorf("targetSchemaMismatches() = %q, want a missing column", got)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -17,6 +18,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &dashboardDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &dashboardDataSource{}
 
 func NewDashboardDataSource() datasource.DataSource {
 	return &dashboardDataSource{}
@@ -27,8 +29,14 @@ type dashboardDataSource struct {
 	client *timeplus.Client
 }
 
-// dashboardDataSourceModel describes the data source data model.
-type dashboardDataSourceModel dashboardResourceModel
+// dashboardDataSourceModel describes the data source data model, the panels are kept as a JSON array.
+type dashboardDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	NameRegex   types.String `tfsdk:"name_regex"`
+	Description types.String `tfsdk:"description"`
+	Panels      types.String `tfsdk:"panels"`
+}
 
 func (d *dashboardDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_dashboard"
@@ -40,14 +48,6 @@ func (d *dashboardDataSource) Schema(ctx context.Context, req datasource.SchemaR
 		MarkdownDescription: "A dashboard is a set of one or more panels organized and arranged in one web page. A variety of panels are supported to make it easy to construct the visualization components so that you can create the dashboards for specific monitoring and analytics needs.",
 
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "The dashboard immutable ID, generated by Timeplus",
-				Required:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "The human-friendly name for the dashboard",
-				Computed:            true,
-			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "A detailed text describes the dashboard",
 				Computed:            true,
@@ -58,6 +58,9 @@ func (d *dashboardDataSource) Schema(ctx context.Context, req datasource.SchemaR
 			},
 		},
 	}
+
+	// lookup keys
+	maps.Copy(resp.Schema.Attributes, lookupAttributes("dashboard"))
 }
 
 func (d *dashboardDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -80,6 +83,19 @@ func (d *dashboardDataSource) Configure(ctx context.Context, req datasource.Conf
 	d.client = client
 }
 
+// ValidateConfig makes sure exactly one lookup key is set.
+func (d *dashboardDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data *dashboardDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateLookup("dashboard", data.ID, data.Name, data.NameRegex)...)
+}
+
 func (d *dashboardDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data *dashboardDataSourceModel
 
@@ -90,6 +106,22 @@ func (d *dashboardDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
+	if data.ID.IsNull() {
+		l, err := d.client.ListDashboards()
+		if err != nil {
+			resp.Diagnostics.AddError("Error Listing Dashboards", fmt.Sprintf("Unable to list dashboards, got error: %s", err))
+			return
+		}
+
+		found, diags := findByName("dashboard", l, func(s timeplus.Dashboard) string { return s.Name }, data.Name, data.NameRegex)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.ID = types.StringValue(found.ID)
+	}
+
 	s, err := d.client.GetDashboard(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
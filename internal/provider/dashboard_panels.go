@@ -0,0 +1,271 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	myplanmodifier "github.com/timeplus-io/terraform-provider-timeplus/internal/planmodifier"
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/timeplus"
+	myvalidator "github.com/timeplus-io/terraform-provider-timeplus/internal/validator"
+)
+
+// panelModel describes a panel of the dashboard resource
+type panelModel struct {
+	ID          types.String `tfsdk:"id"`
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	Position    types.Map    `tfsdk:"position"`
+	VizType     types.String `tfsdk:"viz_type"`
+	VizContent  types.String `tfsdk:"viz_content"`
+	VizConfig   types.String `tfsdk:"viz_config"`
+}
+
+var panelAttrTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"title":       types.StringType,
+	"description": types.StringType,
+	"position":    types.MapType{ElemType: types.Int64Type},
+	"viz_type":    types.StringType,
+	"viz_content": types.StringType,
+	"viz_config":  types.StringType,
+}
+
+// panelAttribute returns the `panel` attribute of the dashboard resource
+func panelAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "The panels of the dashboard, as an alternative to the JSON array of `panels`. Exactly one of `panels` and `panel` must be set. The SQL in `viz_content` and the JSON of `viz_config` are compared semantically, formatting changes are ignored.",
+		Optional:            true,
+		PlanModifiers: []planmodifier.List{
+			myplanmodifier.ListSemanticEquality("Ignores JSON and SQL formatting changes of the panels.", panelsListEqual),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					MarkdownDescription: "The panel ID, it's generated by Timeplus if it's not set",
+					Optional:            true,
+					Computed:            true,
+				},
+				"title": schema.StringAttribute{
+					MarkdownDescription: "The title of the panel",
+					Optional:            true,
+				},
+				"description": schema.StringAttribute{
+					MarkdownDescription: "A detailed text describes the panel",
+					Optional:            true,
+				},
+				"position": schema.MapAttribute{
+					MarkdownDescription: "The position and size of the panel in the grid, e.g. `{ x = 0, y = 0, w = 6, h = 2, nextX = 6, nextY = 2 }`",
+					ElementType:         types.Int64Type,
+					Optional:            true,
+				},
+				"viz_type": schema.StringAttribute{
+					MarkdownDescription: "The type of the panel, e.g. `chart`, `markdown` or `control`",
+					Required:            true,
+				},
+				"viz_content": schema.StringAttribute{
+					MarkdownDescription: "The content of the panel, it's the SQL query for charts",
+					Optional:            true,
+				},
+				"viz_config": schema.StringAttribute{
+					MarkdownDescription: "A JSON object defines the configurations of the visualization, e.g. `jsonencode({ chartType = \"line\" })`",
+					Optional:            true,
+					Validators: []validator.String{
+						myvalidator.JsonObject(),
+					},
+				},
+			},
+		},
+	}
+}
+
+// toPanel converts the panel model to the panel sent to the API
+func (m panelModel) toPanel() (timeplus.Panel, error) {
+	p := timeplus.Panel{
+		ID:          m.ID.ValueString(),
+		Title:       m.Title.ValueString(),
+		Description: m.Description.ValueString(),
+		VizType:     m.VizType.ValueString(),
+		VizContent:  m.VizContent.ValueString(),
+	}
+
+	if !m.Position.IsNull() {
+		p.Position = make(map[string]any, len(m.Position.Elements()))
+		for k, v := range m.Position.Elements() {
+			if n, ok := v.(types.Int64); ok {
+				p.Position[k] = float64(n.ValueInt64())
+			}
+		}
+	}
+
+	if m.VizConfig.ValueString() != "" {
+		if err := json.Unmarshal([]byte(m.VizConfig.ValueString()), &p.VizConfig); err != nil {
+			return p, fmt.Errorf("invalid viz_config of panel %q: %w", p.Title, err)
+		}
+	}
+
+	return p, nil
+}
+
+// known reports whether all fields but the ID are known, the ID is unknown when it's generated by the server
+func (m panelModel) known() bool {
+	if m.Position.IsUnknown() {
+		return false
+	}
+	for _, v := range m.Position.Elements() {
+		if v.IsUnknown() {
+			return false
+		}
+	}
+	return !(m.Title.IsUnknown() || m.Description.IsUnknown() || m.VizType.IsUnknown() || m.VizContent.IsUnknown() || m.VizConfig.IsUnknown())
+}
+
+// panelModelFrom converts the panel returned by the API to the panel model. Empty optional fields are kept unset, if they are unset in `prior`.
+func panelModelFrom(p timeplus.Panel, prior *panelModel) (panelModel, error) {
+	if prior == nil {
+		prior = &panelModel{
+			Title:       types.StringNull(),
+			Description: types.StringNull(),
+			Position:    types.MapNull(types.Int64Type),
+			VizContent:  types.StringNull(),
+			VizConfig:   types.StringNull(),
+		}
+	}
+
+	optional := func(v string, prior types.String) types.String {
+		if v == "" && prior.IsNull() {
+			return prior
+		}
+		return types.StringValue(v)
+	}
+
+	m := panelModel{
+		ID:          types.StringValue(p.ID),
+		Title:       optional(p.Title, prior.Title),
+		Description: optional(p.Description, prior.Description),
+		Position:    types.MapNull(types.Int64Type),
+		VizType:     types.StringValue(p.VizType),
+		VizContent:  optional(p.VizContent, prior.VizContent),
+		VizConfig:   types.StringNull(),
+	}
+
+	if len(p.Position) > 0 || !prior.Position.IsNull() {
+		elems := make(map[string]attr.Value, len(p.Position))
+		for k, v := range p.Position {
+			if n, ok := v.(float64); ok {
+				elems[k] = types.Int64Value(int64(n))
+			}
+		}
+		m.Position = types.MapValueMust(types.Int64Type, elems)
+	}
+
+	if len(p.VizConfig) > 0 || !prior.VizConfig.IsNull() {
+		config := p.VizConfig
+		if config == nil {
+			// it's `{}` rather than `null`, the attribute must be a JSON object
+			config = map[string]any{}
+		}
+		b, err := json.Marshal(config)
+		if err != nil {
+			return m, err
+		}
+		m.VizConfig = types.StringValue(string(b))
+	}
+
+	return m, nil
+}
+
+// panelsFrom converts the panels list to the panels sent to the API
+func panelsFrom(ctx context.Context, l types.List) ([]timeplus.Panel, []panelModel, diag.Diagnostics) {
+	// e.g. the state of an imported dashboard
+	if l.IsNull() {
+		return nil, nil, nil
+	}
+
+	var models []panelModel
+	diags := l.ElementsAs(ctx, &models, false)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	panels := make([]timeplus.Panel, 0, len(models))
+	for _, m := range models {
+		p, err := m.toPanel()
+		if err != nil {
+			diags.AddError("Invalid Panel", err.Error())
+			return nil, nil, diags
+		}
+		panels = append(panels, p)
+	}
+	return panels, models, diags
+}
+
+// panelsListValue converts the panels returned by the API to the panels list, `prior` are the panel models in the
+// state or plan, they are matched by index.
+func panelsListValue(ctx context.Context, panels []timeplus.Panel, prior []panelModel) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	models := make([]panelModel, 0, len(panels))
+	for i, p := range panels {
+		var pm *panelModel
+		if i < len(prior) {
+			pm = &prior[i]
+		}
+
+		m, err := panelModelFrom(p, pm)
+		if err != nil {
+			diags.AddError("Panel Encoding Failed", fmt.Sprintf("Failed to encode panels from the fetched dashboard: %s", err))
+			return types.ListNull(types.ObjectType{AttrTypes: panelAttrTypes}), diags
+		}
+		models = append(models, m)
+	}
+
+	l, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: panelAttrTypes}, models)
+	diags.Append(d...)
+	return l, diags
+}
+
+// panelsListEqual is panelsEqual for panels lists. Unknown IDs in the plan are taken from the state, since they are generated by the server.
+func panelsListEqual(ctx context.Context, state, plan types.List) bool {
+	var sm, pm []panelModel
+	if diags := state.ElementsAs(ctx, &sm, false); diags.HasError() {
+		return false
+	}
+	if diags := plan.ElementsAs(ctx, &pm, false); diags.HasError() {
+		return false
+	}
+	if len(sm) != len(pm) {
+		return false
+	}
+
+	var sp, pp []timeplus.Panel
+	for i := range pm {
+		if !pm[i].known() {
+			return false
+		}
+		if pm[i].ID.IsUnknown() {
+			pm[i].ID = sm[i].ID
+		}
+
+		s, err := sm[i].toPanel()
+		if err != nil {
+			return false
+		}
+		p, err := pm[i].toPanel()
+		if err != nil {
+			return false
+		}
+		sp, pp = append(sp, s), append(pp, p)
+	}
+
+	return panelsEqual(sp, pp)
+}
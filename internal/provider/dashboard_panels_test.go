@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/timeplus"
+)
+
+func TestPanelsEqual(t *testing.T) {
+	chart := timeplus.Panel{
+		ID:         "p1",
+		VizType:    "chart",
+		VizContent: "select * from cities",
+		VizConfig:  map[string]any{"chartType": "line"},
+	}
+	note := timeplus.Panel{ID: "p2", VizType: "markdown", VizContent: "# Cities"}
+
+	with := func(p timeplus.Panel, f func(p *timeplus.Panel)) timeplus.Panel {
+		f(&p)
+		return p
+	}
+
+	cases := []struct {
+		name string
+		b    []timeplus.Panel
+		want bool
+	}{
+		{
+			name: "same",
+			b:    []timeplus.Panel{chart, note},
+			want: true,
+		},
+		{
+			name: "sql formatting",
+			b:    []timeplus.Panel{with(chart, func(p *timeplus.Panel) { p.VizContent = "SELECT *\nFROM cities" }), note},
+			want: true,
+		},
+		{
+			name: "sql change",
+			b:    []timeplus.Panel{with(chart, func(p *timeplus.Panel) { p.VizContent = "select * from towns" }), note},
+		},
+		{
+			name: "markdown formatting",
+			b:    []timeplus.Panel{chart, with(note, func(p *timeplus.Panel) { p.VizContent = "#  Cities" })},
+		},
+		{
+			name: "viz config",
+			b:    []timeplus.Panel{with(chart, func(p *timeplus.Panel) { p.VizConfig = map[string]any{"chartType": "bar"} }), note},
+		},
+		{
+			name: "order",
+			b:    []timeplus.Panel{note, chart},
+		},
+		{
+			name: "length",
+			b:    []timeplus.Panel{chart},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := panelsEqual([]timeplus.Panel{chart, note}, c.b); got != c.want {
+				t.Errorf("panelsEqual() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestPanelModelFrom(t *testing.T) {
+	p := timeplus.Panel{ID: "p1", VizType: "markdown", VizContent: "# Cities"}
+
+	// empty optional fields are kept unset
+	m, err := panelModelFrom(p, nil)
+	if err != nil {
+		t.Fatalf("panelModelFrom() error = %v", err)
+	}
+	if !m.Title.IsNull() || !m.Description.IsNull() || !m.Position.IsNull() || !m.VizConfig.IsNull() {
+		t.Errorf("panelModelFrom() = %+v, want null optional fields", m)
+	}
+	if m.ID.ValueString() != "p1" || m.VizContent.ValueString() != "# Cities" {
+		t.Errorf("panelModelFrom() = %+v, want the ID and content of the panel", m)
+	}
+
+	// empty optional fields set in the prior model are kept set
+	prior := panelModel{
+		Title:       types.StringValue(""),
+		Description: types.StringNull(),
+		Position:    types.MapValueMust(types.Int64Type, nil),
+		VizContent:  types.StringNull(),
+		VizConfig:   types.StringValue("{}"),
+	}
+	p.Position = map[string]any{"x": float64(2)}
+	m, err = panelModelFrom(p, &prior)
+	if err != nil {
+		t.Fatalf("panelModelFrom() error = %v", err)
+	}
+	if m.Title.IsNull() || m.Title.ValueString() != "" {
+		t.Errorf("title = %s, want an empty string", m.Title)
+	}
+	if !m.Description.IsNull() {
+		t.Errorf("description = %s, want null", m.Description)
+	}
+	if got := m.Position.Elements()["x"]; got == nil || got.(types.Int64).ValueInt64() != 2 {
+		t.Errorf("position = %s, want x = 2", m.Position)
+	}
+	if m.VizConfig.ValueString() != "{}" {
+		t.Errorf("viz_config = %s, want {}", m.VizConfig)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	myplanmodifier "github.com/timeplus-io/terraform-provider-timeplus/internal/planmodifier"
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/sqlnorm"
 	"github.com/timeplus-io/terraform-provider-timeplus/internal/timeplus"
 	myvalidator "github.com/timeplus-io/terraform-provider-timeplus/internal/validator"
 )
@@ -24,6 +27,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &dashboardResource{}
 var _ resource.ResourceWithImportState = &dashboardResource{}
+var _ resource.ResourceWithValidateConfig = &dashboardResource{}
 
 func NewDashboardResource() resource.Resource {
 	return &dashboardResource{}
@@ -40,6 +44,54 @@ type dashboardResourceModel struct {
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
 	Panels      types.String `tfsdk:"panels"`
+	Panel       types.List   `tfsdk:"panel"`
+}
+
+// panels returns the panels sent to the API, from either the `panels` JSON array or the `panel` list
+func (m *dashboardResourceModel) panels(ctx context.Context) ([]timeplus.Panel, diag.Diagnostics) {
+	if !m.Panel.IsNull() {
+		panels, _, diags := panelsFrom(ctx, m.Panel)
+		return panels, diags
+	}
+
+	var diags diag.Diagnostics
+	var panels []timeplus.Panel
+	if m.Panels.ValueString() != "" {
+		err := json.Unmarshal([]byte(m.Panels.ValueString()), &panels)
+		if err != nil {
+			diags.AddAttributeError(path.Root("panels"), "Invalid panels JSON", err.Error())
+		}
+	}
+	return panels, diags
+}
+
+// setPanelIDs sets the panel IDs of the `panel` list which are generated by the server
+func (m *dashboardResourceModel) setPanelIDs(ctx context.Context, panels []timeplus.Panel) diag.Diagnostics {
+	if m.Panel.IsNull() {
+		return nil
+	}
+
+	var models []panelModel
+	diags := m.Panel.ElementsAs(ctx, &models, false)
+	if diags.HasError() {
+		return diags
+	}
+
+	for i := range models {
+		if !models[i].ID.IsUnknown() {
+			continue
+		}
+		if i < len(panels) && panels[i].ID != "" {
+			models[i].ID = types.StringValue(panels[i].ID)
+		} else {
+			models[i].ID = types.StringNull()
+		}
+	}
+
+	l, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: panelAttrTypes}, models)
+	diags.Append(d...)
+	m.Panel = l
+	return diags
 }
 
 func (r *dashboardResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -68,12 +120,16 @@ func (r *dashboardResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Optional:            true,
 			},
 			"panels": schema.StringAttribute{
-				MarkdownDescription: "A list of panels defined in a JSON array. The best way to generate such array is to copy it directly from the Timeplus console UI.",
-				Required:            true,
+				MarkdownDescription: "A list of panels defined in a JSON array. The best way to generate such array is to copy it directly from the Timeplus console UI. Formatting changes of the JSON and of the SQL in `viz_content` are ignored. Exactly one of `panels` and `panel` must be set.",
+				Optional:            true,
 				Validators: []validator.String{
 					myvalidator.JsonArrayOfObject(),
 				},
+				PlanModifiers: []planmodifier.String{
+					myplanmodifier.SemanticEquality("Ignores JSON and SQL formatting changes of the panels.", panelsJSONEqual),
+				},
 			},
+			"panel": panelAttribute(),
 		},
 	}
 }
@@ -84,18 +140,36 @@ func (r *dashboardResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*timeplus.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected resource Configure Type",
-			fmt.Sprintf("Expected *timeplus.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+}
+
+// ValidateConfig makes sure exactly one of `panels` and `panel` is set.
+func (r *dashboardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *dashboardResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Panels.IsNull() && data.Panel.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("panels"), "Missing Dashboard Panels", "Either `panels` or `panel` is required.")
+	}
+	if !data.Panels.IsNull() && !data.Panel.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("panel"), "Conflicting Dashboard Panels", "`panels` and `panel` can't be used together.")
+	}
 }
 
 func (r *dashboardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -108,12 +182,11 @@ func (r *dashboardResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	var panels []timeplus.Panel
-	if data.Panels.ValueString() != "" {
-		err := json.Unmarshal([]byte(data.Panels.ValueString()), &panels)
-		if err != nil {
-			resp.Diagnostics.AddAttributeError(path.Root("panels"), "Invalid panels JSON", err.Error())
-		}
+	panels, diags := data.panels(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	s := timeplus.Dashboard{
@@ -130,6 +203,7 @@ func (r *dashboardResource) Create(ctx context.Context, req resource.CreateReque
 
 	// set Computed fields
 	data.ID = types.StringValue(s.ID)
+	resp.Diagnostics.Append(data.setPanelIDs(ctx, s.Panels)...)
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
@@ -150,6 +224,11 @@ func (r *dashboardResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	s, err := r.client.GetDashboard(data.ID.ValueString())
+	if timeplus.IsNotFound(err) {
+		// the dashboard is deleted outside of Terraform, e.g. in the console
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Dashboard",
@@ -161,20 +240,37 @@ func (r *dashboardResource) Read(ctx context.Context, req resource.ReadRequest,
 	// required fields
 	data.Name = types.StringValue(s.Name)
 
-	var panels []timeplus.Panel
-	if err := json.Unmarshal([]byte(data.Panels.ValueString()), &panels); err != nil {
-		resp.Diagnostics.AddError("Panel Decoding Failed", fmt.Sprintf("Failed to decode the panels JSON stored in state: %s", err))
-		return
-	}
+	// only update the panels when they do not match what the API returns, an imported dashboard has neither `panel` nor
+	// `panels`, it's filled into `panels`
+	if !data.Panel.IsNull() {
+		panels, models, diags := panelsFrom(ctx, data.Panel)
+		resp.Diagnostics.Append(diags...)
 
-	// only update data.Panels when it does not match what the API returns
-	if !reflect.DeepEqual(panels, s.Panels) {
-		bytes, err := json.Marshal(s.Panels)
-		if err != nil {
-			resp.Diagnostics.AddError("Panel Encoding Failed", fmt.Sprintf("Failed to encode panels from the fetched dashboard: %s", err.Error()))
+		if resp.Diagnostics.HasError() {
 			return
 		}
-		data.Panels = types.StringValue(string(bytes))
+
+		if !panelsEqual(panels, s.Panels) {
+			data.Panel, diags = panelsListValue(ctx, s.Panels, models)
+			resp.Diagnostics.Append(diags...)
+		}
+	} else {
+		var panels []timeplus.Panel
+		if !data.Panels.IsNull() {
+			if err := json.Unmarshal([]byte(data.Panels.ValueString()), &panels); err != nil {
+				resp.Diagnostics.AddError("Panel Decoding Failed", fmt.Sprintf("Failed to decode the panels JSON stored in state: %s", err))
+				return
+			}
+		}
+
+		if data.Panels.IsNull() || !panelsEqual(panels, s.Panels) {
+			bytes, err := json.Marshal(s.Panels)
+			if err != nil {
+				resp.Diagnostics.AddError("Panel Encoding Failed", fmt.Sprintf("Failed to encode panels from the fetched dashboard: %s", err.Error()))
+				return
+			}
+			data.Panels = types.StringValue(string(bytes))
+		}
 	}
 
 	// optional fields
@@ -196,12 +292,11 @@ func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	var panels []timeplus.Panel
-	if data.Panels.ValueString() != "" {
-		err := json.Unmarshal([]byte(data.Panels.ValueString()), &panels)
-		if err != nil {
-			resp.Diagnostics.AddAttributeError(path.Root("panels"), "Invalid panels JSON", err.Error())
-		}
+	panels, diags := data.panels(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	s := timeplus.Dashboard{
@@ -216,6 +311,8 @@ func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	resp.Diagnostics.Append(data.setPanelIDs(ctx, s.Panels)...)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -236,6 +333,60 @@ func (r *dashboardResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 }
 
+// ImportState accepts either the ID, or the name in the form of `name:<name>`. The rest of the state is filled by Read.
 func (r *dashboardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id, diags := resolveImportID("dashboard", req.ID, r.client.ListDashboards,
+		func(s timeplus.Dashboard) string { return s.Name },
+		func(s timeplus.Dashboard) string { return s.ID },
+	)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// panelsEqual reports whether two lists of panels are the same. The SQL queries of the panels are compared semantically,
+// other kinds of viz_content (e.g. markdown) must be exactly the same.
+func panelsEqual(a, b []timeplus.Panel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		pa, pb := a[i], b[i]
+		if pa.VizType != pb.VizType {
+			return false
+		}
+
+		if pa.VizType == "markdown" {
+			if pa.VizContent != pb.VizContent {
+				return false
+			}
+		} else if !sqlnorm.Equal(pa.VizContent, pb.VizContent) {
+			return false
+		}
+
+		pa.VizContent, pb.VizContent = "", ""
+		if !reflect.DeepEqual(pa, pb) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// panelsJSONEqual is panelsEqual for panels encoded in JSON arrays. Invalid JSON is never equal to anything.
+func panelsJSONEqual(a, b string) bool {
+	var pa, pb []timeplus.Panel
+	if err := json.Unmarshal([]byte(a), &pa); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &pb); err != nil {
+		return false
+	}
+
+	return panelsEqual(pa, pb)
 }
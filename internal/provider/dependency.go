@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/timeplus"
+)
+
+// dependentStore keeps the definitions of the dependents deleted by `cascade_delete`, until the object they depend on
+// is recreated. When a stream is replaced, the old stream is deleted and then the new stream is created in the same
+// apply, the dependents are recreated after the new stream is created.
+type dependentStore struct {
+	mu      sync.Mutex
+	deleted map[string][]timeplus.ObjectDefinition
+}
+
+func newDependentStore() *dependentStore {
+	return &dependentStore{deleted: map[string][]timeplus.ObjectDefinition{}}
+}
+
+// put keeps the definitions of the dependents of the object, in the order they were deleted
+func (s *dependentStore) put(name string, defs []timeplus.ObjectDefinition) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deleted[name] = append(s.deleted[name], defs...)
+}
+
+// take returns and forgets the definitions of the dependents of the object
+func (s *dependentStore) take(name string) []timeplus.ObjectDefinition {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	defs := s.deleted[name]
+	delete(s.deleted, name)
+	return defs
+}
+
+// deleteDependents makes sure nothing depends on the object before it gets deleted. When cascade is true, the
+// definitions of all direct and indirect dependents are read, then the dependents are deleted (the ones depend on
+// others are deleted earlier). The definitions are kept, so that recreateDependents recreates the dependents when the
+// object is recreated, e.g. it's replaced. Otherwise, an error lists the dependents is reported, so that the users know
+// what need to be changed.
+func deleteDependents(ctx context.Context, data *providerData, kind, name string, cascade bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	dependents, err := collectDependents(data.client, name)
+	if timeplus.IsNotFound(err) {
+		// either the object is already gone, or the server does not track its dependencies
+		tflog.Debug(ctx, "no dependencies found", map[string]any{"name": name})
+		return diags
+	}
+	if err != nil {
+		diags.AddError("Error Reading Dependencies", fmt.Sprintf("Unable to read the objects depend on %s %q, got error: %s", kind, name, err))
+		return diags
+	}
+
+	if len(dependents) == 0 {
+		return diags
+	}
+
+	list := make([]string, 0, len(dependents))
+	for _, obj := range dependents {
+		list = append(list, describeObject(obj))
+	}
+
+	if !cascade {
+		diags.AddError(fmt.Sprintf("Unable to Delete %s", titleCase(kind)),
+			fmt.Sprintf("The following objects depend on %s %q:\n  - %s\n\nDelete them or change them to not use %q first, or set `cascade_delete = true` (and apply it) to delete them before the %s is deleted, and recreate them after the %s is recreated.",
+				kind, name, strings.Join(list, "\n  - "), name, kind, kind))
+		return diags
+	}
+
+	// nothing is deleted unless all the dependents can be recreated
+	defs := make([]timeplus.ObjectDefinition, 0, len(dependents))
+	for _, obj := range dependents {
+		def, err := data.client.GetObjectDefinition(obj)
+		if err != nil {
+			diags.AddError("Error Reading Dependent", fmt.Sprintf("Unable to read %s which depends on %s %q, got error: %s", describeObject(obj), kind, name, err))
+			return diags
+		}
+		defs = append(defs, def)
+	}
+
+	for i, def := range defs {
+		tflog.Info(ctx, "deleting dependent object", map[string]any{"object": describeObject(def.Ref), "dependency": name})
+		if err := data.client.DeleteObject(def.Ref); err != nil && !timeplus.IsNotFound(err) {
+			diags.AddError("Error Deleting Dependent", fmt.Sprintf("Unable to delete %s which depends on %s %q, got error: %s", describeObject(def.Ref), kind, name, err))
+			// the object is kept, so are its dependents
+			diags.Append(recreateObjects(ctx, data.client, defs[:i])...)
+			return diags
+		}
+	}
+
+	data.dependents.put(name, defs)
+	diags.AddWarning(fmt.Sprintf("Dependents of %s Deleted", titleCase(kind)),
+		fmt.Sprintf("The following objects depend on %s %q are deleted:\n  - %s\n\nThey are recreated after the %s is recreated in this apply. They are not recreated if the %s is only destroyed.",
+			kind, name, strings.Join(list, "\n  - "), kind, kind))
+
+	return diags
+}
+
+// recreateDependents recreates the dependents deleted by deleteDependents, after the object they depend on is created.
+func recreateDependents(ctx context.Context, data *providerData, name string) diag.Diagnostics {
+	return recreateObjects(ctx, data.client, data.dependents.take(name))
+}
+
+// recreateObjects recreates the objects in the reverse order they were deleted, i.e. an object is recreated after the
+// objects it depends on. Failures are reported as warnings, since the object they depend on is created successfully.
+func recreateObjects(ctx context.Context, client *timeplus.Client, defs []timeplus.ObjectDefinition) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for i := len(defs) - 1; i >= 0; i-- {
+		def := defs[i]
+		tflog.Info(ctx, "recreating dependent object", map[string]any{"object": describeObject(def.Ref)})
+
+		ref, err := client.RecreateObject(def)
+		if err != nil {
+			diags.AddWarning("Error Recreating Dependent", fmt.Sprintf("Unable to recreate %s, got error: %s. It has to be recreated manually.", describeObject(def.Ref), err))
+			continue
+		}
+		if ref.ID != def.Ref.ID {
+			diags.AddWarning("Dependent Recreated With New ID", fmt.Sprintf("%s is recreated as %s. If it's managed by Terraform, import it with the new ID.", describeObject(def.Ref), describeObject(ref)))
+		}
+	}
+	return diags
+}
+
+// collectDependents returns all the downstream objects of the object in the order they can be deleted, i.e. an object
+// always comes before the objects it depends on.
+func collectDependents(client *timeplus.Client, name string) ([]timeplus.ObjectRef, error) {
+	var (
+		result  []timeplus.ObjectRef
+		visited = map[timeplus.ObjectRef]bool{}
+		visit   func(name string) error
+	)
+
+	visit = func(name string) error {
+		deps, err := client.GetDependencies(name)
+		if err != nil {
+			return err
+		}
+
+		for _, obj := range deps.Downstreams {
+			if visited[obj] {
+				continue
+			}
+			visited[obj] = true
+
+			// sinks are always at the end of the lineage
+			if obj.Type != timeplus.ObjectTypeSink {
+				if err := visit(obj.Name); err != nil {
+					return err
+				}
+			}
+			result = append(result, obj)
+		}
+		return nil
+	}
+
+	if err := visit(name); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func describeObject(obj timeplus.ObjectRef) string {
+	kind := strings.ReplaceAll(obj.Type, "_", " ")
+	if obj.ID != "" {
+		return fmt.Sprintf("%s %q (id: %s)", kind, obj.Name, obj.ID)
+	}
+	return fmt.Sprintf("%s %q", kind, obj.Name)
+}
@@ -0,0 +1,264 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/timeplus"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &dictionaryDataSource{}
+
+func NewDictionaryDataSource() datasource.DataSource {
+	return &dictionaryDataSource{}
+}
+
+// dictionaryDataSource defines the data source implementation.
+type dictionaryDataSource struct {
+	client *timeplus.Client
+}
+
+// dictionaryClickHouseSourceDataModel is the same as externalTableSettingsModel except the password, which is never returned by the API.
+type dictionaryClickHouseSourceDataModel struct {
+	Address  types.String `tfsdk:"address"`
+	Database types.String `tfsdk:"database"`
+	Table    types.String `tfsdk:"table"`
+	User     types.String `tfsdk:"user"`
+	Secure   types.Bool   `tfsdk:"secure"`
+}
+
+type dictionarySourceDataModel struct {
+	Type       types.String                         `tfsdk:"type"`
+	Stream     types.String                         `tfsdk:"stream"`
+	ClickHouse *dictionaryClickHouseSourceDataModel `tfsdk:"clickhouse"`
+	HTTP       *dictionaryHTTPSourceModel           `tfsdk:"http"`
+}
+
+// dictionaryDataSourceModel describes the data source data model.
+type dictionaryDataSourceModel struct {
+	Name        types.String               `tfsdk:"name"`
+	Description types.String               `tfsdk:"description"`
+	Keys        []dictionaryColumnModel    `tfsdk:"keys"`
+	Attributes  []dictionaryColumnModel    `tfsdk:"attributes"`
+	Source      *dictionarySourceDataModel `tfsdk:"source"`
+	Layout      types.String               `tfsdk:"layout"`
+	Range       *dictionaryRangeModel      `tfsdk:"range"`
+	LifetimeMin types.Int64                `tfsdk:"lifetime_min"`
+	LifetimeMax types.Int64                `tfsdk:"lifetime_max"`
+}
+
+func dictionaryColumnsAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					MarkdownDescription: "The column name",
+					Computed:            true,
+				},
+				"type": schema.StringAttribute{
+					MarkdownDescription: "The type name of the column",
+					Computed:            true,
+				},
+				"default": schema.StringAttribute{
+					MarkdownDescription: "The value returned when the key is not found in the dictionary",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func (d *dictionaryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dictionary"
+}
+
+func (d *dictionaryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Timeplus dictionaries are in-memory key-value lookup tables loaded from streams, mutable streams, ClickHouse tables or HTTP endpoints. They are typically used with `dict_get` to enrich streaming data in materialized views.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The dictionary name",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A detailed text describes the dictionary",
+				Computed:            true,
+			},
+			"keys":       dictionaryColumnsAttribute("The columns which make up the dictionary key"),
+			"attributes": dictionaryColumnsAttribute("The columns which can be looked up from the dictionary"),
+			"source": schema.SingleNestedAttribute{
+				MarkdownDescription: "Where the dictionary loads data from",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The source type. Options: stream, mutable_stream, clickhouse, http",
+						Computed:            true,
+					},
+					"stream": schema.StringAttribute{
+						MarkdownDescription: "The name of the stream or mutable stream",
+						Computed:            true,
+					},
+					"clickhouse": schema.SingleNestedAttribute{
+						MarkdownDescription: "The settings to connect to the ClickHouse table",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"address": schema.StringAttribute{
+								MarkdownDescription: "The address of the database server, in the `host:port` format",
+								Computed:            true,
+							},
+							"database": schema.StringAttribute{
+								MarkdownDescription: "The database the table belongs to",
+								Computed:            true,
+							},
+							"table": schema.StringAttribute{
+								MarkdownDescription: "The name of the table in the database",
+								Computed:            true,
+							},
+							"user": schema.StringAttribute{
+								MarkdownDescription: "The user name used to connect to the database server",
+								Computed:            true,
+							},
+							"secure": schema.BoolAttribute{
+								MarkdownDescription: "Whether TLS is used to connect to the database server",
+								Computed:            true,
+							},
+						},
+					},
+					"http": schema.SingleNestedAttribute{
+						MarkdownDescription: "The HTTP endpoint serves the dictionary data",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"url": schema.StringAttribute{
+								MarkdownDescription: "The URL of the HTTP endpoint",
+								Computed:            true,
+							},
+							"format": schema.StringAttribute{
+								MarkdownDescription: "The format of the response body",
+								Computed:            true,
+							},
+						},
+					},
+				},
+			},
+			"layout": schema.StringAttribute{
+				MarkdownDescription: "How the dictionary is stored in memory",
+				Computed:            true,
+			},
+			"range": schema.SingleNestedAttribute{
+				MarkdownDescription: "The columns define the range of each row, only available for the `range_hashed` and `complex_key_range_hashed` layouts",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"min": schema.StringAttribute{
+						MarkdownDescription: "The column contains the lower bound of the range",
+						Computed:            true,
+					},
+					"max": schema.StringAttribute{
+						MarkdownDescription: "The column contains the upper bound of the range",
+						Computed:            true,
+					},
+				},
+			},
+			"lifetime_min": schema.Int64Attribute{
+				MarkdownDescription: "The minimum seconds before the dictionary is reloaded",
+				Computed:            true,
+			},
+			"lifetime_max": schema.Int64Attribute{
+				MarkdownDescription: "The maximum seconds before the dictionary is reloaded, 0 means the dictionary is never reloaded",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *dictionaryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*timeplus.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *timeplus.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *dictionaryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *dictionaryDataSourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dict, err := d.client.GetDictionary(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Dictionary", fmt.Sprintf("Unable to read dictionary %q, got error: %s", data.Name.ValueString(), err))
+		return
+	}
+
+	data.Name = types.StringValue(dict.Name)
+	data.Description = types.StringValue(dict.Description)
+	data.Keys = dictionaryColumnsFrom(dict.Keys)
+	data.Attributes = dictionaryColumnsFrom(dict.Attributes)
+	data.Layout = types.StringValue(string(dict.Layout))
+	data.LifetimeMin = types.Int64Value(int64(dict.LifetimeMin))
+	data.LifetimeMax = types.Int64Value(int64(dict.LifetimeMax))
+
+	if dict.RangeMin != "" || dict.RangeMax != "" {
+		data.Range = &dictionaryRangeModel{
+			Min: types.StringValue(dict.RangeMin),
+			Max: types.StringValue(dict.RangeMax),
+		}
+	}
+
+	if s := dict.Source; s != nil {
+		data.Source = &dictionarySourceDataModel{
+			Type: types.StringValue(string(s.Type)),
+		}
+
+		if s.Stream != "" {
+			data.Source.Stream = types.StringValue(s.Stream)
+		}
+
+		if ch := s.ClickHouse; ch != nil {
+			data.Source.ClickHouse = &dictionaryClickHouseSourceDataModel{
+				Address:  types.StringValue(ch.Address),
+				Database: types.StringValue(ch.Database),
+				Table:    types.StringValue(ch.Table),
+				User:     types.StringValue(ch.User),
+				Secure:   types.BoolValue(ch.Secure),
+			}
+		}
+
+		if h := s.HTTP; h != nil {
+			data.Source.HTTP = &dictionaryHTTPSourceModel{
+				URL:    types.StringValue(h.URL),
+				Format: types.StringValue(h.Format),
+			}
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,521 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/timeplus"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &dictionaryResource{}
+var _ resource.ResourceWithImportState = &dictionaryResource{}
+var _ resource.ResourceWithValidateConfig = &dictionaryResource{}
+
+func NewDictionaryResource() resource.Resource {
+	return &dictionaryResource{}
+}
+
+// dictionaryResource defines the resource implementation.
+type dictionaryResource struct {
+	client *timeplus.Client
+}
+
+type dictionaryColumnModel struct {
+	Name    types.String `tfsdk:"name"`
+	Type    types.String `tfsdk:"type"`
+	Default types.String `tfsdk:"default"`
+}
+
+type dictionaryHTTPSourceModel struct {
+	URL    types.String `tfsdk:"url"`
+	Format types.String `tfsdk:"format"`
+}
+
+type dictionarySourceModel struct {
+	Type       types.String                `tfsdk:"type"`
+	Stream     types.String                `tfsdk:"stream"`
+	ClickHouse *externalTableSettingsModel `tfsdk:"clickhouse"`
+	HTTP       *dictionaryHTTPSourceModel  `tfsdk:"http"`
+}
+
+type dictionaryRangeModel struct {
+	Min types.String `tfsdk:"min"`
+	Max types.String `tfsdk:"max"`
+}
+
+// dictionaryResourceModel describes the dictionary resource data model.
+type dictionaryResourceModel struct {
+	Name        types.String            `tfsdk:"name"`
+	Description types.String            `tfsdk:"description"`
+	Keys        []dictionaryColumnModel `tfsdk:"key"`
+	Attributes  []dictionaryColumnModel `tfsdk:"attribute"`
+	Source      *dictionarySourceModel  `tfsdk:"source"`
+	Layout      types.String            `tfsdk:"layout"`
+	Range       *dictionaryRangeModel   `tfsdk:"range"`
+	LifetimeMin types.Int64             `tfsdk:"lifetime_min"`
+	LifetimeMax types.Int64             `tfsdk:"lifetime_max"`
+}
+
+func (m *dictionaryResourceModel) toDictionary() timeplus.Dictionary {
+	d := timeplus.Dictionary{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Keys:        toDictionaryColumns(m.Keys),
+		Attributes:  toDictionaryColumns(m.Attributes),
+		Layout:      timeplus.DictionaryLayout(m.Layout.ValueString()),
+		LifetimeMin: int(m.LifetimeMin.ValueInt64()),
+		LifetimeMax: int(m.LifetimeMax.ValueInt64()),
+	}
+
+	if m.Range != nil {
+		d.RangeMin = m.Range.Min.ValueString()
+		d.RangeMax = m.Range.Max.ValueString()
+	}
+
+	if m.Source != nil {
+		d.Source = &timeplus.DictionarySource{
+			Type:   timeplus.DictionarySourceType(m.Source.Type.ValueString()),
+			Stream: m.Source.Stream.ValueString(),
+		}
+		if s := m.Source.ClickHouse; s != nil {
+			d.Source.ClickHouse = &timeplus.ExternalTableSettings{
+				Address:  s.Address.ValueString(),
+				Database: s.Database.ValueString(),
+				Table:    s.Table.ValueString(),
+				User:     s.User.ValueString(),
+				Password: s.Password.ValueString(),
+				Secure:   s.Secure.ValueBool(),
+			}
+		}
+		if s := m.Source.HTTP; s != nil {
+			d.Source.HTTP = &timeplus.DictionaryHTTPSource{
+				URL:    s.URL.ValueString(),
+				Format: s.Format.ValueString(),
+			}
+		}
+	}
+
+	return d
+}
+
+func toDictionaryColumns(models []dictionaryColumnModel) []timeplus.DictionaryColumn {
+	columns := make([]timeplus.DictionaryColumn, 0, len(models))
+	for i := range models {
+		columns = append(columns, timeplus.DictionaryColumn{
+			Name:    models[i].Name.ValueString(),
+			Type:    models[i].Type.ValueString(),
+			Default: models[i].Default.ValueString(),
+		})
+	}
+	return columns
+}
+
+func dictionaryColumnsFrom(columns []timeplus.DictionaryColumn) []dictionaryColumnModel {
+	models := make([]dictionaryColumnModel, 0, len(columns))
+	for i := range columns {
+		models = append(models, dictionaryColumnModel{
+			Name:    types.StringValue(columns[i].Name),
+			Type:    types.StringValue(columns[i].Type),
+			Default: types.StringValue(columns[i].Default),
+		})
+	}
+	return models
+}
+
+func dictionaryColumnBlock(description string) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		MarkdownDescription: description,
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.RequiresReplace(),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					MarkdownDescription: "The column name",
+					Required:            true,
+				},
+				"type": schema.StringAttribute{
+					MarkdownDescription: "The type name of the column",
+					Required:            true,
+				},
+				"default": schema.StringAttribute{
+					MarkdownDescription: "The value returned when the key is not found in the dictionary",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString(""),
+				},
+			},
+		},
+	}
+}
+
+func (r *dictionaryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dictionary"
+}
+
+func (r *dictionaryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Timeplus dictionaries are in-memory key-value lookup tables loaded from streams, mutable streams, ClickHouse tables or HTTP endpoints. They are typically used with `dict_get` to enrich streaming data in materialized views.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The dictionary name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A detailed text describes the dictionary",
+				Optional:            true,
+			},
+			"layout": schema.StringAttribute{
+				MarkdownDescription: "How the dictionary is stored in memory. Options: flat, hashed, sparse_hashed, complex_key_hashed, range_hashed, complex_key_range_hashed, cache, complex_key_cache, direct, complex_key_direct, ip_trie. Default: \"hashed\"",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(timeplus.DictionaryLayoutHashed)),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"lifetime_min": schema.Int64Attribute{
+				MarkdownDescription: "The dictionary is reloaded at a random time between `lifetime_min` and `lifetime_max` seconds. Default: 0",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"lifetime_max": schema.Int64Attribute{
+				MarkdownDescription: "The dictionary is reloaded at a random time between `lifetime_min` and `lifetime_max` seconds. 0 means the dictionary is never reloaded. Default: 0",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"key":       dictionaryColumnBlock("Define a column of the dictionary key, multiple key columns make up a complex key (which requires a `complex_key_*` layout). Changing the keys will lead to a recreation."),
+			"attribute": dictionaryColumnBlock("Define a column which can be looked up from the dictionary. Changing the attributes will lead to a recreation."),
+			"source": schema.SingleNestedBlock{
+				MarkdownDescription: "Where the dictionary loads data from. Changing the source will lead to a recreation.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The source type. Options: stream, mutable_stream, clickhouse, http",
+						Optional:            true,
+					},
+					"stream": schema.StringAttribute{
+						MarkdownDescription: "The name of the stream or mutable stream, required when `type` is `stream` or `mutable_stream`",
+						Optional:            true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"clickhouse": externalTableSettingsBlock("The settings to connect to the ClickHouse table, required when `type` is `clickhouse`"),
+					"http": schema.SingleNestedBlock{
+						MarkdownDescription: "The HTTP endpoint serves the dictionary data, required when `type` is `http`",
+						Attributes: map[string]schema.Attribute{
+							"url": schema.StringAttribute{
+								MarkdownDescription: "The URL of the HTTP endpoint",
+								Optional:            true,
+							},
+							"format": schema.StringAttribute{
+								MarkdownDescription: "The format of the response body, e.g. `JSONEachRow`, `CSVWithNames`",
+								Optional:            true,
+							},
+						},
+					},
+				},
+			},
+			"range": schema.SingleNestedBlock{
+				MarkdownDescription: "The columns define the range of each row, required by the `range_hashed` and `complex_key_range_hashed` layouts. Changing the range will lead to a recreation.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"min": schema.StringAttribute{
+						MarkdownDescription: "The column contains the lower bound of the range",
+						Optional:            true,
+					},
+					"max": schema.StringAttribute{
+						MarkdownDescription: "The column contains the upper bound of the range",
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *dictionaryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *dictionaryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *dictionaryResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(data.Keys) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("key"), "No Keys", "At least one key column must be defined for a dictionary.")
+	}
+
+	if len(data.Attributes) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("attribute"), "No Attributes", "At least one attribute column must be defined for a dictionary.")
+	}
+
+	if !data.Layout.IsUnknown() {
+		layout, err := timeplus.DictionaryLayoutFrom(data.Layout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("layout"), "Invalid Layout", err.Error())
+		} else if layout.IsRange() && data.Range == nil {
+			resp.Diagnostics.AddAttributeError(path.Root("range"), "Missing Range", fmt.Sprintf("The `range` block is required by the %q layout.", layout))
+		} else if !layout.IsRange() && data.Range != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("range"), "Unexpected Range", fmt.Sprintf("The `range` block can't be used with the %q layout.", layout))
+		}
+	}
+
+	if !data.LifetimeMin.IsUnknown() && !data.LifetimeMax.IsUnknown() && data.LifetimeMin.ValueInt64() > data.LifetimeMax.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(path.Root("lifetime_min"), "Invalid Lifetime", "`lifetime_min` can't be greater than `lifetime_max`.")
+	}
+
+	if data.Source == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Missing Source", "The `source` block is required.")
+		return
+	}
+
+	if data.Source.Type.IsUnknown() {
+		return
+	}
+
+	typ, err := timeplus.DictionarySourceTypeFrom(data.Source.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source").AtName("type"), "Invalid Source Type", err.Error())
+		return
+	}
+
+	isStream := typ == timeplus.DictionarySourceStream || typ == timeplus.DictionarySourceMutableStream
+	if isStream != !data.Source.Stream.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("source").AtName("stream"), "Invalid Source", fmt.Sprintf("`stream` is required when the source type is `stream` or `mutable_stream`, and can't be used otherwise, got type %q.", typ))
+	}
+
+	if (typ == timeplus.DictionarySourceClickHouse) != (data.Source.ClickHouse != nil) {
+		resp.Diagnostics.AddAttributeError(path.Root("source").AtName("clickhouse"), "Invalid Source", fmt.Sprintf("The `clickhouse` block is required when the source type is `clickhouse`, and can't be used otherwise, got type %q.", typ))
+	}
+
+	if (typ == timeplus.DictionarySourceHTTP) != (data.Source.HTTP != nil) {
+		resp.Diagnostics.AddAttributeError(path.Root("source").AtName("http"), "Invalid Source", fmt.Sprintf("The `http` block is required when the source type is `http`, and can't be used otherwise, got type %q.", typ))
+	}
+}
+
+func (r *dictionaryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *dictionaryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d := data.toDictionary()
+	if err := r.client.CreateDictionary(&d); err != nil {
+		resp.Diagnostics.AddError("Error Creating Dictionary", fmt.Sprintf("Unable to create dictionary %q, got error: %s", d.Name, err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a timeplus_dictionary resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dictionaryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *dictionaryResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d, err := r.client.GetDictionary(data.Name.ValueString())
+	if timeplus.IsNotFound(err) {
+		// the dictionary is deleted outside of Terraform, e.g. by `cascade_delete` of the object it depends on
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Dictionary",
+			fmt.Sprintf("Unable to read dictionary %q, got error: %s",
+				data.Name.ValueString(), err))
+		return
+	}
+
+	// required fields
+	data.Name = types.StringValue(d.Name)
+	data.Keys = dictionaryColumnsFrom(d.Keys)
+	data.Attributes = dictionaryColumnsFrom(d.Attributes)
+	data.Layout = types.StringValue(string(d.Layout))
+	data.LifetimeMin = types.Int64Value(int64(d.LifetimeMin))
+	data.LifetimeMax = types.Int64Value(int64(d.LifetimeMax))
+
+	// the prior source is nil when the resource is being imported
+	prior := data.Source
+	if prior == nil {
+		prior = &dictionarySourceModel{}
+	}
+
+	if d.Source == nil {
+		d.Source = &timeplus.DictionarySource{}
+	}
+
+	source := &dictionarySourceModel{
+		Type: types.StringValue(string(d.Source.Type)),
+	}
+
+	if d.Source.Stream != "" {
+		source.Stream = types.StringValue(d.Source.Stream)
+	}
+
+	if s := d.Source.ClickHouse; s != nil {
+		priorClickHouse := prior.ClickHouse
+		if priorClickHouse == nil {
+			priorClickHouse = &externalTableSettingsModel{}
+		}
+
+		source.ClickHouse = &externalTableSettingsModel{
+			Address: types.StringValue(s.Address),
+			// API does not return the password, keep the one in state
+			Password: priorClickHouse.Password,
+		}
+		if !(priorClickHouse.Database.IsNull() && s.Database == "") {
+			source.ClickHouse.Database = types.StringValue(s.Database)
+		}
+		if !(priorClickHouse.Table.IsNull() && s.Table == "") {
+			source.ClickHouse.Table = types.StringValue(s.Table)
+		}
+		if !(priorClickHouse.User.IsNull() && s.User == "") {
+			source.ClickHouse.User = types.StringValue(s.User)
+		}
+		if !(priorClickHouse.Secure.IsNull() && !s.Secure) {
+			source.ClickHouse.Secure = types.BoolValue(s.Secure)
+		}
+	}
+
+	if s := d.Source.HTTP; s != nil {
+		source.HTTP = &dictionaryHTTPSourceModel{
+			URL:    types.StringValue(s.URL),
+			Format: types.StringValue(s.Format),
+		}
+	}
+
+	data.Source = source
+
+	// optional fields
+	if d.RangeMin != "" || d.RangeMax != "" {
+		data.Range = &dictionaryRangeModel{
+			Min: types.StringValue(d.RangeMin),
+			Max: types.StringValue(d.RangeMax),
+		}
+	} else {
+		data.Range = nil
+	}
+
+	if !(data.Description.IsNull() && d.Description == "") {
+		data.Description = types.StringValue(d.Description)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dictionaryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *dictionaryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// all the other fields require a recreation, only `description` can be updated in place
+	d := timeplus.Dictionary{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+	}
+	if err := r.client.UpdateDictionary(&d); err != nil {
+		resp.Diagnostics.AddError("Error Updating Dictionary", fmt.Sprintf("Unable to update dictionary %q, got error: %s", d.Name, err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dictionaryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *dictionaryResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDictionary(&timeplus.Dictionary{Name: data.Name.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Dictionary", fmt.Sprintf("Unable to delete dictionary %q, got error: %s", data.Name.ValueString(), err))
+	}
+}
+
+func (r *dictionaryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
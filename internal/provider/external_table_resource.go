@@ -0,0 +1,364 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/timeplus"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &externalTableResource{}
+var _ resource.ResourceWithImportState = &externalTableResource{}
+var _ resource.ResourceWithValidateConfig = &externalTableResource{}
+
+func NewExternalTableResource() resource.Resource {
+	return &externalTableResource{}
+}
+
+// externalTableResource defines the resource implementation.
+type externalTableResource struct {
+	client *timeplus.Client
+}
+
+// externalTableSettingsModel describes the connection settings, it's shared by all the engine blocks.
+type externalTableSettingsModel struct {
+	Address  types.String `tfsdk:"address"`
+	Database types.String `tfsdk:"database"`
+	Table    types.String `tfsdk:"table"`
+	User     types.String `tfsdk:"user"`
+	Password types.String `tfsdk:"password"`
+	Secure   types.Bool   `tfsdk:"secure"`
+}
+
+// externalTableResourceModel describes the external table resource data model.
+type externalTableResourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+
+	// only the block matches `type` can be set
+	ClickHouse *externalTableSettingsModel `tfsdk:"clickhouse"`
+	MySQL      *externalTableSettingsModel `tfsdk:"mysql"`
+	PostgreSQL *externalTableSettingsModel `tfsdk:"postgresql"`
+}
+
+// settings returns the block matches `type`
+func (m *externalTableResourceModel) settings() *externalTableSettingsModel {
+	switch timeplus.ExternalTableType(m.Type.ValueString()) {
+	case timeplus.ExternalTableTypeClickHouse:
+		return m.ClickHouse
+	case timeplus.ExternalTableTypeMySQL:
+		return m.MySQL
+	case timeplus.ExternalTableTypePostgreSQL:
+		return m.PostgreSQL
+	}
+	return nil
+}
+
+// setSettings sets the block matches `type` and clears the other ones
+func (m *externalTableResourceModel) setSettings(s *externalTableSettingsModel) {
+	m.ClickHouse, m.MySQL, m.PostgreSQL = nil, nil, nil
+
+	switch timeplus.ExternalTableType(m.Type.ValueString()) {
+	case timeplus.ExternalTableTypeClickHouse:
+		m.ClickHouse = s
+	case timeplus.ExternalTableTypeMySQL:
+		m.MySQL = s
+	case timeplus.ExternalTableTypePostgreSQL:
+		m.PostgreSQL = s
+	}
+}
+
+func (m *externalTableResourceModel) toExternalTable() timeplus.ExternalTable {
+	t := timeplus.ExternalTable{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Type:        timeplus.ExternalTableType(m.Type.ValueString()),
+	}
+
+	if s := m.settings(); s != nil {
+		t.Settings = timeplus.ExternalTableSettings{
+			Address:  s.Address.ValueString(),
+			Database: s.Database.ValueString(),
+			Table:    s.Table.ValueString(),
+			User:     s.User.ValueString(),
+			Password: s.Password.ValueString(),
+			Secure:   s.Secure.ValueBool(),
+		}
+	}
+
+	return t
+}
+
+// externalTableSettingsBlock returns the schema of the block configures the connection to an external database
+func externalTableSettingsBlock(description string) schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"address": schema.StringAttribute{
+				MarkdownDescription: "The address of the database server, in the `host:port` format",
+				Optional:            true,
+			},
+			"database": schema.StringAttribute{
+				MarkdownDescription: "The database the table belongs to",
+				Optional:            true,
+			},
+			"table": schema.StringAttribute{
+				MarkdownDescription: "The name of the table in the database. When it's not set, the name of the external table will be used.",
+				Optional:            true,
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "The user name used to connect to the database server",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password used to connect to the database server",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"secure": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, TLS will be used to connect to the database server",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *externalTableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_external_table"
+}
+
+func (r *externalTableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Timeplus external tables allow to query or write data in tables of external databases (ClickHouse, MySQL and PostgreSQL) directly, for example, to join streams against dimension tables stored in these databases.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The external table name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A detailed text describes the external table",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the external database. Options: clickhouse, mysql, postgresql. The block with the same name must be set to configure the connection.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			string(timeplus.ExternalTableTypeClickHouse): externalTableSettingsBlock("The settings to connect to the clickhouse table, required when `type` is `clickhouse`"),
+			string(timeplus.ExternalTableTypeMySQL):      externalTableSettingsBlock("The settings to connect to the mysql table, required when `type` is `mysql`"),
+			string(timeplus.ExternalTableTypePostgreSQL): externalTableSettingsBlock("The settings to connect to the postgresql table, required when `type` is `postgresql`"),
+		},
+	}
+}
+
+func (r *externalTableResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *externalTableResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *externalTableResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Type.IsUnknown() {
+		return
+	}
+
+	typ, err := timeplus.ExternalTableTypeFrom(data.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "Invalid Type", err.Error())
+		return
+	}
+
+	blocks := map[timeplus.ExternalTableType]*externalTableSettingsModel{
+		timeplus.ExternalTableTypeClickHouse: data.ClickHouse,
+		timeplus.ExternalTableTypeMySQL:      data.MySQL,
+		timeplus.ExternalTableTypePostgreSQL: data.PostgreSQL,
+	}
+
+	for t, block := range blocks {
+		if t != typ && block != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(string(t)), "Unexpected Block", fmt.Sprintf("The `%s` block can't be used when `type` is %q.", t, typ))
+		}
+	}
+
+	settings := blocks[typ]
+	if settings == nil {
+		resp.Diagnostics.AddAttributeError(path.Root(string(typ)), "Missing Block", fmt.Sprintf("The `%s` block is required when `type` is %q.", typ, typ))
+		return
+	}
+
+	if settings.Address.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root(string(typ)).AtName("address"), "Missing Address", "The address of the database server is required.")
+	}
+}
+
+func (r *externalTableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *externalTableResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	t := data.toExternalTable()
+	if err := r.client.CreateExternalTable(&t); err != nil {
+		resp.Diagnostics.AddError("Error Creating External Table", fmt.Sprintf("Unable to create external table %q, got error: %s", t.Name, err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a timeplus_external_table resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *externalTableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *externalTableResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	t, err := r.client.GetExternalTable(data.Name.ValueString())
+	if timeplus.IsNotFound(err) {
+		// the external table is deleted outside of Terraform, e.g. in the console
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading External Table",
+			fmt.Sprintf("Unable to read external table %q, got error: %s",
+				data.Name.ValueString(), err))
+		return
+	}
+
+	// the prior settings is nil when the resource is being imported
+	prior := data.settings()
+	if prior == nil {
+		prior = &externalTableSettingsModel{}
+	}
+
+	// required fields
+	data.Name = types.StringValue(t.Name)
+	data.Type = types.StringValue(string(t.Type))
+
+	settings := &externalTableSettingsModel{
+		Address: types.StringValue(t.Settings.Address),
+		// API does not return the password, keep the one in state
+		Password: prior.Password,
+	}
+
+	// optional fields
+	if !(prior.Database.IsNull() && t.Settings.Database == "") {
+		settings.Database = types.StringValue(t.Settings.Database)
+	}
+
+	// the table name defaults to the external table name
+	if !(prior.Table.IsNull() && (t.Settings.Table == "" || t.Settings.Table == t.Name)) {
+		settings.Table = types.StringValue(t.Settings.Table)
+	}
+
+	if !(prior.User.IsNull() && t.Settings.User == "") {
+		settings.User = types.StringValue(t.Settings.User)
+	}
+
+	if !(prior.Secure.IsNull() && !t.Settings.Secure) {
+		settings.Secure = types.BoolValue(t.Settings.Secure)
+	}
+
+	data.setSettings(settings)
+
+	if !(data.Description.IsNull() && t.Description == "") {
+		data.Description = types.StringValue(t.Description)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *externalTableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *externalTableResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	t := data.toExternalTable()
+	if err := r.client.UpdateExternalTable(&t); err != nil {
+		resp.Diagnostics.AddError("Error Updating External Table", fmt.Sprintf("Unable to update external table %q, got error: %s", t.Name, err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *externalTableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *externalTableResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteExternalTable(&timeplus.ExternalTable{Name: data.Name.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting External Table", fmt.Sprintf("Unable to delete external table %q, got error: %s", data.Name.ValueString(), err))
+	}
+}
+
+// ImportState imports an external table by its name. The password is not returned by the API, so it has to be set in the configuration after the import.
+func (r *externalTableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
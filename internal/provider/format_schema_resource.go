@@ -0,0 +1,245 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	myplanmodifier "github.com/timeplus-io/terraform-provider-timeplus/internal/planmodifier"
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/timeplus"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &formatSchemaResource{}
+var _ resource.ResourceWithImportState = &formatSchemaResource{}
+var _ resource.ResourceWithModifyPlan = &formatSchemaResource{}
+
+func NewFormatSchemaResource() resource.Resource {
+	return &formatSchemaResource{}
+}
+
+// formatSchemaResource defines the resource implementation.
+type formatSchemaResource struct {
+	client *timeplus.Client
+}
+
+// formatSchemaResourceModel describes the format schema resource data model.
+type formatSchemaResourceModel struct {
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+	Body types.String `tfsdk:"body"`
+	Hash types.String `tfsdk:"hash"`
+}
+
+// formatSchemaHash returns the hash used to detect content changes of a format schema. Leading and trailing spaces are insignificant.
+func formatSchemaHash(body string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(body)))
+	return hex.EncodeToString(sum[:])
+}
+
+func (r *formatSchemaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_format_schema"
+}
+
+func (r *formatSchemaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Timeplus format schemas are Protobuf or Avro schemas registered in Timeplus. External streams and sinks using the `ProtobufSingle`, `Protobuf` or `Avro` data formats refer to a format schema by name via the `format_schema` setting. Format schemas can't be modified, any change leads to a recreation.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The format schema name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The format schema type. Options: protobuf, avro",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"body": schema.StringAttribute{
+				MarkdownDescription: "The content of the schema, i.e. the content of the `.proto` file for protobuf, or the schema JSON for avro. Changes of leading or trailing spaces are ignored.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					myplanmodifier.SemanticEquality("Ignores changes that do not change the hash of the format schema body.", func(a, b string) bool {
+						return formatSchemaHash(a) == formatSchemaHash(b)
+					}),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hash": schema.StringAttribute{
+				MarkdownDescription: "The SHA256 hash of the body, it can be used in `replace_triggered_by` to recreate the streams or sinks use the schema when it changes",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *formatSchemaResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+// ModifyPlan computes the hash at plan time, so that resources depend on it know about the change before apply.
+func (r *formatSchemaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data *formatSchemaResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Type.IsUnknown() {
+		if _, err := timeplus.FormatSchemaTypeFrom(data.Type.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("type"), "Invalid Type", err.Error())
+			return
+		}
+	}
+
+	if data.Body.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("hash"), formatSchemaHash(data.Body.ValueString()))...)
+}
+
+func (r *formatSchemaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *formatSchemaResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	s := timeplus.FormatSchema{
+		Name: data.Name.ValueString(),
+		Type: timeplus.FormatSchemaType(data.Type.ValueString()),
+		Body: data.Body.ValueString(),
+	}
+	if err := r.client.CreateFormatSchema(&s); err != nil {
+		resp.Diagnostics.AddError("Error Creating Format Schema", fmt.Sprintf("Unable to create format schema %q, got error: %s", s.Name, err))
+		return
+	}
+
+	// Computed fields
+	data.Hash = types.StringValue(formatSchemaHash(data.Body.ValueString()))
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a timeplus_format_schema resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *formatSchemaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *formatSchemaResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	s, err := r.client.GetFormatSchema(data.Name.ValueString())
+	if timeplus.IsNotFound(err) {
+		// the format schema is deleted outside of Terraform, e.g. in the console
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Format Schema",
+			fmt.Sprintf("Unable to read format schema %q, got error: %s",
+				data.Name.ValueString(), err))
+		return
+	}
+
+	// required fields
+	data.Name = types.StringValue(s.Name)
+	data.Type = types.StringValue(strings.ToLower(string(s.Type)))
+
+	// only update the body when the content is actually changed, so that the original formatting is kept
+	if hash := formatSchemaHash(s.Body); hash != data.Hash.ValueString() {
+		data.Body = types.StringValue(s.Body)
+		data.Hash = types.StringValue(hash)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *formatSchemaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All attributes require a recreation, thus nothing needs to be updated via the API.
+	var data *formatSchemaResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *formatSchemaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *formatSchemaResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteFormatSchema(&timeplus.FormatSchema{Name: data.Name.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Format Schema", fmt.Sprintf("Unable to delete format schema %q, got error: %s", data.Name.ValueString(), err))
+	}
+}
+
+func (r *formatSchemaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// stringsFrom converts the string values of a list attribute to the strings sent to the API
+func stringsFrom(values []types.String) []string {
+	ss := make([]string, 0, len(values))
+	for _, v := range values {
+		ss = append(ss, v.ValueString())
+	}
+	return ss
+}
+
+// stringValuesFrom converts the strings returned by the API to the string values of a list attribute
+func stringValuesFrom(ss []string) []types.String {
+	values := make([]types.String, 0, len(ss))
+	for _, s := range ss {
+		values = append(values, types.StringValue(s))
+	}
+	return values
+}
@@ -26,7 +26,14 @@ type javascriptFunctionDataSource struct {
 }
 
 // javascriptFunctionDataSourceModel describes the data source data model.
-type javascriptFunctionDataSourceModel javascriptFunctionResourceModel
+type javascriptFunctionDataSourceModel struct {
+	Name           types.String            `tfsdk:"name"`
+	Description    types.String            `tfsdk:"description"`
+	Arguments      []functionArgumentModel `tfsdk:"arg"`
+	ReturnType     types.String            `tfsdk:"return_type"`
+	Source         types.String            `tfsdk:"source"`
+	IsAggrFunction types.Bool              `tfsdk:"is_aggregate_function"`
+}
 
 func (d *javascriptFunctionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_javascript_function"
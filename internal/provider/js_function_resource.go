@@ -18,6 +18,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &javascriptFunctionResource{}
 var _ resource.ResourceWithImportState = &javascriptFunctionResource{}
+var _ resource.ResourceWithValidateConfig = &javascriptFunctionResource{}
 
 func NewJavascriptFunctionResource() resource.Resource {
 	return &javascriptFunctionResource{}
@@ -30,12 +31,13 @@ type javascriptFunctionResource struct {
 
 // javascriptFunctionResourceModel describes the stream resource data model.
 type javascriptFunctionResourceModel struct {
-	Name           types.String            `tfsdk:"name"`
-	Description    types.String            `tfsdk:"description"`
-	Arguments      []functionArgumentModel `tfsdk:"arg"`
-	ReturnType     types.String            `tfsdk:"return_type"`
-	Source         types.String            `tfsdk:"source"`
-	IsAggrFunction types.Bool              `tfsdk:"is_aggregate_function"`
+	Name           types.String                  `tfsdk:"name"`
+	Description    types.String                  `tfsdk:"description"`
+	Arguments      []functionArgumentModel       `tfsdk:"arg"`
+	ReturnType     types.String                  `tfsdk:"return_type"`
+	Source         types.String                  `tfsdk:"source"`
+	IsAggrFunction types.Bool                    `tfsdk:"is_aggregate_function"`
+	Tests          []javascriptFunctionTestModel `tfsdk:"test"`
 }
 
 func (r *javascriptFunctionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -61,7 +63,7 @@ func (r *javascriptFunctionResource) Schema(_ context.Context, _ resource.Schema
 				Optional:            true,
 			},
 			"source": schema.StringAttribute{
-				MarkdownDescription: "The javascript function source code",
+				MarkdownDescription: "The javascript function source code. The source of an aggregate function is checked to define the `initialize`, `process`, `finalize`, `serialize`, `deserialize` and `merge` methods, and `process` to take the arguments of the function",
 				Required:            true,
 			},
 			"return_type": schema.StringAttribute{
@@ -85,6 +87,7 @@ func (r *javascriptFunctionResource) Schema(_ context.Context, _ resource.Schema
 					},
 				},
 			},
+			"test": javascriptFunctionTestBlock(),
 		},
 	}
 }
@@ -95,18 +98,37 @@ func (r *javascriptFunctionResource) Configure(_ context.Context, req resource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(*timeplus.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *timeplus.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+}
+
+// ValidateConfig checks the source of aggregate functions and runs the tests of the function.
+func (r *javascriptFunctionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *javascriptFunctionConfigModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the tests can't pass if the aggregate function breaks the contract
+	resp.Diagnostics.Append(checkAggregateFunction(data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(runJavascriptFunctionTests(ctx, data)...)
 }
 
 func (r *javascriptFunctionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -160,6 +182,11 @@ func (r *javascriptFunctionResource) Read(ctx context.Context, req resource.Read
 	}
 
 	s, err := r.client.GetUDF(data.Name.ValueString())
+	if timeplus.IsNotFound(err) {
+		// the javascript function is deleted outside of Terraform, e.g. in the console
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading JavascriptFunction",
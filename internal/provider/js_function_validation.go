@@ -0,0 +1,208 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/jsudf"
+	myvalidator "github.com/timeplus-io/terraform-provider-timeplus/internal/validator"
+)
+
+// javascriptFunctionConfigModel is the configuration of the javascript function resource checked by ValidateConfig. The
+// blocks are lists rather than slices, since they are unknown when they are `dynamic` blocks over an unknown `for_each`.
+type javascriptFunctionConfigModel struct {
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Arguments      types.List   `tfsdk:"arg"`
+	ReturnType     types.String `tfsdk:"return_type"`
+	Source         types.String `tfsdk:"source"`
+	IsAggrFunction types.Bool   `tfsdk:"is_aggregate_function"`
+	Tests          types.List   `tfsdk:"test"`
+}
+
+// javascriptFunctionTestModel describes a `test` block of the javascript function resource
+type javascriptFunctionTestModel struct {
+	Args     types.String `tfsdk:"args"`
+	Rows     types.String `tfsdk:"rows"`
+	Expected types.String `tfsdk:"expected"`
+}
+
+// javascriptFunctionTestBlock returns the `test` block of the javascript function resource
+func javascriptFunctionTestBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		MarkdownDescription: "A test case of the javascript function. The tests are run with an embedded javascript engine when the configuration is validated, the plan fails if the source has syntax errors or a function returns an unexpected result.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"args": schema.StringAttribute{
+					MarkdownDescription: "A JSON array of the arguments of one call to the function, e.g. `jsonencode([1, 2])`. Only for non-aggregate functions",
+					Optional:            true,
+				},
+				"rows": schema.StringAttribute{
+					MarkdownDescription: "A JSON array of the rows processed by the aggregate function, each row is an array of the arguments, e.g. `jsonencode([[1], [3], [2]])`. Only for aggregate functions",
+					Optional:            true,
+				},
+				"expected": schema.StringAttribute{
+					MarkdownDescription: "The JSON encoded value the function is expected to return, e.g. `jsonencode(3)`",
+					Required:            true,
+					Validators: []validator.String{
+						myvalidator.JsonValue(),
+					},
+				},
+			},
+		},
+	}
+}
+
+// checkAggregateFunction statically checks the source of an aggregate function defines the lifecycle methods, and
+// `process` takes the arguments of the function.
+func checkAggregateFunction(data *javascriptFunctionConfigModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !data.IsAggrFunction.ValueBool() || data.Source.IsUnknown() || data.Arguments.IsUnknown() {
+		return diags
+	}
+
+	problems, err := jsudf.CheckAggregate(data.Source.ValueString(), len(data.Arguments.Elements()))
+	if err != nil {
+		diags.AddAttributeError(path.Root("source"), "Invalid Javascript", err.Error())
+		return diags
+	}
+	for _, p := range problems {
+		diags.AddAttributeError(path.Root("source"), "Invalid Javascript Aggregate Function", p)
+	}
+	return diags
+}
+
+// runJavascriptFunctionTests runs the test cases of the javascript function and reports the failed ones. Tests which
+// are not known yet are skipped.
+func runJavascriptFunctionTests(ctx context.Context, data *javascriptFunctionConfigModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Name.IsUnknown() || data.Source.IsUnknown() || data.IsAggrFunction.IsUnknown() || data.Arguments.IsUnknown() || data.Tests.IsUnknown() {
+		return diags
+	}
+	for _, e := range data.Tests.Elements() {
+		if e.IsUnknown() {
+			return diags
+		}
+	}
+
+	var tests []javascriptFunctionTestModel
+	diags.Append(data.Tests.ElementsAs(ctx, &tests, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	argc := len(data.Arguments.Elements())
+	aggregate := data.IsAggrFunction.ValueBool()
+	for i, t := range tests {
+		p := path.Root("test").AtListIndex(i)
+		if t.Args.IsUnknown() || t.Rows.IsUnknown() || t.Expected.IsUnknown() {
+			continue
+		}
+
+		var expected any
+		if err := json.Unmarshal([]byte(t.Expected.ValueString()), &expected); err != nil {
+			// reported by the validator of the attribute
+			continue
+		}
+
+		var (
+			got any
+			err error
+		)
+		if aggregate {
+			rows, d := testRows(p, t, argc)
+			diags.Append(d...)
+			if d.HasError() {
+				continue
+			}
+			got, err = jsudf.Aggregate(data.Source.ValueString(), rows)
+		} else {
+			args, d := testArgs(p, t, argc)
+			diags.Append(d...)
+			if d.HasError() {
+				continue
+			}
+			got, err = jsudf.Call(data.Name.ValueString(), data.Source.ValueString(), args)
+		}
+
+		var syntaxErr *jsudf.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			// all the tests fail for the same reason
+			diags.AddAttributeError(path.Root("source"), "Invalid Javascript", syntaxErr.Message)
+			return diags
+		}
+		if err != nil {
+			diags.AddAttributeError(p, "Javascript Function Test Failed", fmt.Sprintf("Test %d failed with error: %s", i, err))
+			continue
+		}
+
+		if !jsonEqual(got, expected) {
+			diags.AddAttributeError(p, "Javascript Function Test Failed", fmt.Sprintf("Test %d expected %s, got %s", i, t.Expected.ValueString(), jsonString(got)))
+		}
+	}
+
+	return diags
+}
+
+// testArgs returns the arguments of a test of a non-aggregate function
+func testArgs(p path.Path, t javascriptFunctionTestModel, argc int) ([]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if t.Args.IsNull() || !t.Rows.IsNull() {
+		diags.AddAttributeError(p, "Invalid Javascript Function Test", "Tests of non-aggregate functions must set `args` but not `rows`.")
+		return nil, diags
+	}
+
+	var args []any
+	if err := json.Unmarshal([]byte(t.Args.ValueString()), &args); err != nil {
+		diags.AddAttributeError(p.AtName("args"), "Invalid Javascript Function Test", fmt.Sprintf("`args` must be a JSON array: %s", err))
+		return nil, diags
+	}
+	if len(args) != argc {
+		diags.AddAttributeError(p.AtName("args"), "Invalid Javascript Function Test", fmt.Sprintf("The function takes %d arguments, got %d.", argc, len(args)))
+	}
+	return args, diags
+}
+
+// testRows returns the rows of a test of an aggregate function
+func testRows(p path.Path, t javascriptFunctionTestModel, argc int) ([][]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if t.Rows.IsNull() || !t.Args.IsNull() {
+		diags.AddAttributeError(p, "Invalid Javascript Function Test", "Tests of aggregate functions must set `rows` but not `args`.")
+		return nil, diags
+	}
+
+	var rows [][]any
+	if err := json.Unmarshal([]byte(t.Rows.ValueString()), &rows); err != nil {
+		diags.AddAttributeError(p.AtName("rows"), "Invalid Javascript Function Test", fmt.Sprintf("`rows` must be a JSON array of arrays: %s", err))
+		return nil, diags
+	}
+	for i, row := range rows {
+		if len(row) != argc {
+			diags.AddAttributeError(p.AtName("rows"), "Invalid Javascript Function Test", fmt.Sprintf("The function takes %d arguments, got %d in row %d.", argc, len(row), i))
+		}
+	}
+	return rows, diags
+}
+
+// jsonString encodes the value returned by a function for error messages
+func jsonString(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
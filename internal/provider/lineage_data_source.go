@@ -0,0 +1,248 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/sqlnorm"
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/timeplus"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &lineageDataSource{}
+
+func NewLineageDataSource() datasource.DataSource {
+	return &lineageDataSource{}
+}
+
+// lineageDataSource defines the data source implementation.
+type lineageDataSource struct {
+	client *timeplus.Client
+}
+
+// lineageDataSourceModel describes the data source data model.
+type lineageDataSourceModel struct {
+	Name       types.String     `tfsdk:"name"`
+	Recursive  types.Bool       `tfsdk:"recursive"`
+	Upstream   []objectRefModel `tfsdk:"upstream"`
+	Downstream []objectRefModel `tfsdk:"downstream"`
+}
+
+type objectRefModel struct {
+	Type types.String `tfsdk:"type"`
+	Name types.String `tfsdk:"name"`
+	ID   types.String `tfsdk:"id"`
+}
+
+func objectRefAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"type": schema.StringAttribute{
+			MarkdownDescription: "The object type, e.g. `stream`, `external_stream`, `view`, `materialized_view`, `dictionary`, `external_table`, `function`, `sink`, `source` or `dashboard`",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The object name",
+			Computed:            true,
+		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The object ID, it's only set for sinks, sources and dashboards",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *lineageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_lineage"
+}
+
+func (d *lineageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "The lineage of a Timeplus object, i.e. the objects it reads data from (upstream) and the objects read data from it (downstream). It's useful to find out what will be affected before changing or deleting a stream or a view. Dashboards are reported as downstream when the query of any of their panels refers to the object (or to one of its downstream objects when `recursive` is `true`).",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the stream, view, materialized view, dictionary or external table",
+				Required:            true,
+			},
+			"recursive": schema.BoolAttribute{
+				MarkdownDescription: "When it's `true`, the indirect upstream and downstream objects are included as well. Default: false",
+				Optional:            true,
+			},
+			"upstream": schema.ListNestedAttribute{
+				MarkdownDescription: "The objects the object reads data from",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: objectRefAttributes(),
+				},
+			},
+			"downstream": schema.ListNestedAttribute{
+				MarkdownDescription: "The objects read data from the object",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: objectRefAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *lineageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*timeplus.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *timeplus.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *lineageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *lineageDataSourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	deps, err := d.client.GetDependencies(name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Lineage", fmt.Sprintf("Unable to read dependencies of %q, got error: %s", name, err))
+		return
+	}
+
+	upstreams, downstreams := deps.Upstreams, deps.Downstreams
+	if data.Recursive.ValueBool() {
+		upstreams, err = d.walk(upstreams, func(deps timeplus.Dependencies) []timeplus.ObjectRef { return deps.Upstreams })
+		if err == nil {
+			downstreams, err = d.walk(downstreams, func(deps timeplus.Dependencies) []timeplus.ObjectRef { return deps.Downstreams })
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Error Reading Lineage", fmt.Sprintf("Unable to read indirect dependencies of %q, got error: %s", name, err))
+			return
+		}
+	}
+
+	// dashboards are directly downstream of the objects their panels query
+	names := []string{name}
+	if data.Recursive.ValueBool() {
+		for _, obj := range downstreams {
+			// sinks and sources can't be queried
+			if obj.Type != timeplus.ObjectTypeSink && obj.Type != timeplus.ObjectTypeSource {
+				names = append(names, obj.Name)
+			}
+		}
+	}
+
+	dashboards, err := d.dashboards(names)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Lineage", fmt.Sprintf("Unable to list dashboards reading from %q, got error: %s", name, err))
+		return
+	}
+	downstreams = append(downstreams, dashboards...)
+
+	data.Upstream = objectRefModels(upstreams)
+	data.Downstream = objectRefModels(downstreams)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// walk follows the dependencies of the objects (in one direction picked by `next`) in breadth-first order, and
+// returns all objects reached. Every object is only visited once, so that cycles do not cause infinite loops.
+func (d *lineageDataSource) walk(objs []timeplus.ObjectRef, next func(timeplus.Dependencies) []timeplus.ObjectRef) ([]timeplus.ObjectRef, error) {
+	visited := make(map[timeplus.ObjectRef]bool, len(objs))
+	var result []timeplus.ObjectRef
+
+	queue := objs
+	for len(queue) > 0 {
+		obj := queue[0]
+		queue = queue[1:]
+
+		if visited[obj] {
+			continue
+		}
+		visited[obj] = true
+		result = append(result, obj)
+
+		// sinks and sources are always at the edges of the lineage
+		if obj.Type == timeplus.ObjectTypeSink || obj.Type == timeplus.ObjectTypeSource {
+			continue
+		}
+
+		deps, err := d.client.GetDependencies(obj.Name)
+		if err != nil {
+			return nil, err
+		}
+		queue = append(queue, next(deps)...)
+	}
+
+	return result, nil
+}
+
+// dashboards returns the dashboards whose panels query any of the objects.
+func (d *lineageDataSource) dashboards(names []string) ([]timeplus.ObjectRef, error) {
+	dashboards, err := d.client.ListDashboards()
+	if err != nil {
+		return nil, err
+	}
+
+	var refs []timeplus.ObjectRef
+	for _, dashboard := range dashboards {
+		if dashboardReferences(dashboard, names) {
+			refs = append(refs, timeplus.ObjectRef{Type: timeplus.ObjectTypeDashboard, Name: dashboard.Name, ID: dashboard.ID})
+		}
+	}
+	return refs, nil
+}
+
+// dashboardReferences reports whether the query of any panel of the dashboard refers to one of the objects.
+func dashboardReferences(dashboard timeplus.Dashboard, names []string) bool {
+	for _, p := range dashboard.Panels {
+		// the content of markdown panels is not a query
+		if p.VizType == "markdown" {
+			continue
+		}
+		for _, name := range names {
+			if sqlnorm.References(p.VizContent, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func objectRefModels(objs []timeplus.ObjectRef) []objectRefModel {
+	models := make([]objectRefModel, 0, len(objs))
+	for _, obj := range objs {
+		m := objectRefModel{
+			Type: types.StringValue(obj.Type),
+			Name: types.StringValue(obj.Name),
+			ID:   types.StringNull(),
+		}
+		if obj.ID != "" {
+			m.ID = types.StringValue(obj.ID)
+		}
+		models = append(models, m)
+	}
+	return models
+}
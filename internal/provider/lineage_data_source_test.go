@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/timeplus"
+)
+
+func TestDashboardReferences(t *testing.T) {
+	dashboard := timeplus.Dashboard{
+		Panels: []timeplus.Panel{
+			{VizType: "markdown", VizContent: "Data of the cities stream"},
+			{VizType: "chart", VizContent: "select city, avg(temp) from hot_cities group by city"},
+		},
+	}
+
+	cases := []struct {
+		names []string
+		want  bool
+	}{
+		{names: []string{"hot_cities"}, want: true},
+		{names: []string{"cities", "hot_cities"}, want: true},
+		{names: []string{"cities"}},
+		{names: []string{"temp_view"}},
+	}
+
+	for _, c := range cases {
+		if got := dashboardReferences(dashboard, c.names); got != c.want {
+			t.Errorf("dashboardReferences(%v) = %v, want %v", c.names, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,362 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/timeplus"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &listDataSource[timeplus.Stream]{}
+var _ datasource.DataSourceWithValidateConfig = &listDataSource[timeplus.Stream]{}
+
+func NewStreamsDataSource() datasource.DataSource {
+	return &listDataSource[timeplus.Stream]{
+		kind:     "stream",
+		typeName: "streams",
+		typeAttr: "mode",
+		typeDesc: "The mode of the streams, e.g. `append`, `changelog_kv` or `versioned_kv`",
+		list:     (*timeplus.Client).ListStreams,
+		item: func(s timeplus.Stream) listItem {
+			mode := s.Mode
+			if mode == "" {
+				mode = string(timeplus.StreamModeAppend)
+			}
+			return listItem{ID: s.Name, Name: s.Name, Description: s.Description, Type: mode, Labels: s.Labels}
+		},
+	}
+}
+
+func NewViewsDataSource() datasource.DataSource {
+	return &listDataSource[timeplus.View]{
+		kind:     "view",
+		typeName: "views",
+		list:     (*timeplus.Client).ListViews,
+		item: func(v timeplus.View) listItem {
+			return listItem{ID: v.Name, Name: v.Name, Description: v.Description, Labels: v.Labels}
+		},
+	}
+}
+
+func NewMaterializedViewsDataSource() datasource.DataSource {
+	return &listDataSource[timeplus.MaterializedView]{
+		kind:     "materialized view",
+		typeName: "materialized_views",
+		list:     (*timeplus.Client).ListMaterializedViews,
+		item: func(v timeplus.MaterializedView) listItem {
+			return listItem{ID: v.Name, Name: v.Name, Description: v.Description, Labels: v.Labels}
+		},
+	}
+}
+
+func NewSinksDataSource() datasource.DataSource {
+	return &listDataSource[timeplus.Sink]{
+		kind:     "sink",
+		typeName: "sinks",
+		typeAttr: "type",
+		typeDesc: "The type of the sinks, e.g. `kafka`, `http` or `slack`",
+		list:     (*timeplus.Client).ListSinks,
+		item: func(s timeplus.Sink) listItem {
+			return listItem{ID: s.ID, Name: s.Name, Description: s.Description, Type: s.Type, Labels: s.Labels}
+		},
+	}
+}
+
+func NewSourcesDataSource() datasource.DataSource {
+	return &listDataSource[timeplus.Source]{
+		kind:     "source",
+		typeName: "sources",
+		typeAttr: "type",
+		typeDesc: "The type of the sources, e.g. `kafka` or `redpanda`",
+		list:     (*timeplus.Client).ListSources,
+		item: func(s timeplus.Source) listItem {
+			return listItem{ID: s.ID, Name: s.Name, Description: s.Description, Type: s.Type, Labels: s.Labels}
+		},
+	}
+}
+
+func NewFunctionsDataSource() datasource.DataSource {
+	return &listDataSource[timeplus.UDF]{
+		kind:     "function",
+		typeName: "functions",
+		typeAttr: "type",
+		typeDesc: "The type of the functions, e.g. `javascript`, `python` or `remote`",
+		list:     (*timeplus.Client).ListUDFs,
+		item: func(u timeplus.UDF) listItem {
+			return listItem{ID: u.Name, Name: u.Name, Description: u.Description, Type: string(u.Type), Labels: u.Labels}
+		},
+	}
+}
+
+func NewDashboardsDataSource() datasource.DataSource {
+	return &listDataSource[timeplus.Dashboard]{
+		kind:     "dashboard",
+		typeName: "dashboards",
+		list:     (*timeplus.Client).ListDashboards,
+		item: func(d timeplus.Dashboard) listItem {
+			return listItem{ID: d.ID, Name: d.Name, Description: d.Description, Labels: d.Labels}
+		},
+	}
+}
+
+// listItem is the summary of an object returned by the list data sources
+type listItem struct {
+	ID          string
+	Name        string
+	Description string
+	// the mode of streams, or the type of sinks, sources and functions
+	Type   string
+	Labels map[string]string
+}
+
+// listDataSource lists the objects of a kind (e.g. all streams) with filters.
+type listDataSource[T any] struct {
+	client *timeplus.Client
+
+	// the kind of the objects in plain words, e.g. `materialized view`
+	kind string
+	// the data source type name without the provider name, it's also the name of the attribute holds the objects, e.g. `materialized_views`
+	typeName string
+	// the name of the attribute of the type (e.g. `mode` of streams), it's empty if the objects don't have types
+	typeAttr string
+	typeDesc string
+
+	list func(*timeplus.Client) ([]T, error)
+	item func(T) listItem
+}
+
+func (d *listDataSource[T]) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.typeName
+}
+
+func (d *listDataSource[T]) itemAttrTypes() map[string]attr.Type {
+	attrTypes := map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"description": types.StringType,
+		"labels":      types.MapType{ElemType: types.StringType},
+	}
+	if d.typeAttr != "" {
+		attrTypes[d.typeAttr] = types.StringType
+	}
+	return attrTypes
+}
+
+func (d *listDataSource[T]) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	itemAttrs := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The ID of the %s, it's the name if the %s does not have a generated ID", d.kind, d.kind),
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The name of the %s", d.kind),
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("A detailed text describes the %s", d.kind),
+			Computed:            true,
+		},
+		"labels": schema.MapAttribute{
+			MarkdownDescription: fmt.Sprintf("The labels attached to the %s. Labels are managed outside of Terraform (e.g. in the console), the resources never set or change them", d.kind),
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+	}
+
+	attrs := map[string]schema.Attribute{
+		"name_prefix": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Only %ss whose names start with the prefix are returned", d.kind),
+			Optional:            true,
+		},
+		"name_regex": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Only %ss whose names match the regular expression (RE2 syntax) are returned", d.kind),
+			Optional:            true,
+		},
+		"labels": schema.MapAttribute{
+			MarkdownDescription: fmt.Sprintf("Only %ss which have all the labels are returned", d.kind),
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"names": schema.ListAttribute{
+			MarkdownDescription: fmt.Sprintf("The names of the matching %ss, sorted by name. It's handy for `for_each` with `toset()`", d.kind),
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+		d.typeName: schema.ListNestedAttribute{
+			MarkdownDescription: fmt.Sprintf("The matching %ss, sorted by name", d.kind),
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: itemAttrs,
+			},
+		},
+	}
+
+	if d.typeAttr != "" {
+		attrs[d.typeAttr] = schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Only %ss of the %s are returned. %s", d.kind, d.typeAttr, d.typeDesc),
+			Optional:            true,
+		}
+		itemAttrs[d.typeAttr] = schema.StringAttribute{
+			MarkdownDescription: d.typeDesc,
+			Computed:            true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: fmt.Sprintf("Lists the %ss matching all the filters, e.g. to iterate them with `for_each`. All %ss are returned when no filter is set.", d.kind, d.kind),
+
+		Attributes: attrs,
+	}
+}
+
+func (d *listDataSource[T]) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*timeplus.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *timeplus.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig makes sure `name_regex` is valid.
+func (d *listDataSource[T]) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var nameRegex types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name_regex"), &nameRegex)...)
+
+	if resp.Diagnostics.HasError() || nameRegex.IsNull() || nameRegex.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(nameRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Name Regex", err.Error())
+	}
+}
+
+func (d *listDataSource[T]) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var (
+		namePrefix, nameRegex, typ types.String
+		labelsValue                types.Map
+	)
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name_prefix"), &namePrefix)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name_regex"), &nameRegex)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("labels"), &labelsValue)...)
+	if d.typeAttr != "" {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(d.typeAttr), &typ)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	labels := make(map[string]string, len(labelsValue.Elements()))
+	resp.Diagnostics.Append(labelsValue.ElementsAs(ctx, &labels, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var re *regexp.Regexp
+	if !nameRegex.IsNull() {
+		var err error
+		if re, err = regexp.Compile(nameRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Name Regex", err.Error())
+			return
+		}
+	}
+
+	objs, err := d.list(d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error Listing %ss", titleCase(d.kind)), fmt.Sprintf("Unable to list %ss, got error: %s", d.kind, err))
+		return
+	}
+
+	var items []listItem
+	for _, obj := range objs {
+		item := d.item(obj)
+
+		if !strings.HasPrefix(item.Name, namePrefix.ValueString()) {
+			continue
+		}
+		if re != nil && !re.MatchString(item.Name) {
+			continue
+		}
+		if !typ.IsNull() && item.Type != typ.ValueString() {
+			continue
+		}
+		if !hasLabels(item.Labels, labels) {
+			continue
+		}
+
+		items = append(items, item)
+	}
+	slices.SortFunc(items, func(a, b listItem) int { return strings.Compare(a.Name, b.Name) })
+
+	names := make([]attr.Value, 0, len(items))
+	values := make([]attr.Value, 0, len(items))
+	attrTypes := d.itemAttrTypes()
+	for _, item := range items {
+		itemLabels := make(map[string]attr.Value, len(item.Labels))
+		for k, v := range item.Labels {
+			itemLabels[k] = types.StringValue(v)
+		}
+
+		attrs := map[string]attr.Value{
+			"id":          types.StringValue(item.ID),
+			"name":        types.StringValue(item.Name),
+			"description": types.StringValue(item.Description),
+			"labels":      types.MapValueMust(types.StringType, itemLabels),
+		}
+		if d.typeAttr != "" {
+			attrs[d.typeAttr] = types.StringValue(item.Type)
+		}
+
+		value, diags := types.ObjectValue(attrTypes, attrs)
+		resp.Diagnostics.Append(diags...)
+		values = append(values, value)
+		names = append(names, types.StringValue(item.Name))
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the filters are kept as they are in the configuration
+	resp.State.Raw = req.Config.Raw
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("names"), types.ListValueMust(types.StringType, names))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(d.typeName), types.ListValueMust(types.ObjectType{AttrTypes: attrTypes}, values))...)
+}
+
+// hasLabels reports whether `labels` contains all the `wanted` labels
+func hasLabels(labels, wanted map[string]string) bool {
+	for k, v := range wanted {
+		if got, ok := labels[k]; !ok || got != v {
+			return false
+		}
+	}
+	return true
+}
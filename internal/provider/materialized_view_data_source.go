@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/timeplus-io/terraform-provider-timeplus/internal/timeplus"
@@ -30,6 +31,7 @@ type materializedViewDataSourceModel struct {
 	Name           types.String `tfsdk:"name"`
 	Description    types.String `tfsdk:"description"`
 	Query          types.String `tfsdk:"query"`
+	Columns        types.List   `tfsdk:"columns"`
 	TargetStream   types.String `tfsdk:"target_stream"`
 	RetentionBytes types.Int64  `tfsdk:"retention_bytes"`
 	RetentionMS    types.Int64  `tfsdk:"retention_ms"`
@@ -58,6 +60,7 @@ func (d *materializedViewDataSource) Schema(ctx context.Context, req datasource.
 				MarkdownDescription: "The query SQL of the view",
 				Computed:            true,
 			},
+			"columns": columnInfoListDataSourceAttribute("The output columns of the materialized view"),
 			"target_stream": schema.StringAttribute{
 				MarkdownDescription: "The optional stream name that the materialized view writes data to",
 				Computed:            true,
@@ -122,6 +125,16 @@ func (d *materializedViewDataSource) Read(ctx context.Context, req datasource.Re
 	data.RetentionMS = types.Int64Value(int64(v.RetentionMS))
 	data.HistoryTTL = types.StringValue(v.TTLExpression)
 
+	columns, err := d.client.DescribeView(v.Name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Describing Materialized View", fmt.Sprintf("Unable to describe materialized view %q, got error: %s", v.Name, err))
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.Columns, diags = columnInfoListValue(columns)
+	resp.Diagnostics.Append(diags...)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
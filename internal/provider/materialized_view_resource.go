@@ -5,21 +5,28 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	myplanmodifier "github.com/timeplus-io/terraform-provider-timeplus/internal/planmodifier"
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/sqlnorm"
 	"github.com/timeplus-io/terraform-provider-timeplus/internal/timeplus"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &materializedViewResource{}
 var _ resource.ResourceWithImportState = &materializedViewResource{}
+var _ resource.ResourceWithModifyPlan = &materializedViewResource{}
 
 func NewMaterializedViewResource() resource.Resource {
 	return &materializedViewResource{}
@@ -27,7 +34,8 @@ func NewMaterializedViewResource() resource.Resource {
 
 // materializedViewResource defines the resource implementation.
 type materializedViewResource struct {
-	client *timeplus.Client
+	client   *timeplus.Client
+	provider *providerData
 }
 
 // materializedViewResourceModel describes the materialized view resource data model.
@@ -39,6 +47,63 @@ type materializedViewResourceModel struct {
 	RetentionBytes types.Int64  `tfsdk:"retention_bytes"`
 	RetentionMS    types.Int64  `tfsdk:"retention_ms"`
 	HistoryTTL     types.String `tfsdk:"history_ttl"`
+	Paused         types.Bool   `tfsdk:"paused"`
+	CascadeDelete  types.Bool   `tfsdk:"cascade_delete"`
+
+	Settings *materializedViewSettingsModel `tfsdk:"settings"`
+
+	// read-only
+	Status        types.String `tfsdk:"status"`
+	LastError     types.String `tfsdk:"last_error"`
+	TargetColumns types.List   `tfsdk:"target_columns"`
+	Columns       types.List   `tfsdk:"columns"`
+}
+
+// targetSchemaMismatches returns the differences between the query output and the target stream. Extra columns of
+// the target stream are fine, since they are filled with their default values.
+func targetSchemaMismatches(output, target []timeplus.Column) []string {
+	targetTypes := make(map[string]string, len(target))
+	for _, col := range target {
+		targetTypes[col.Name] = col.Type
+	}
+
+	var mismatches []string
+	for _, col := range output {
+		typ, ok := targetTypes[col.Name]
+		if !ok {
+			mismatches = append(mismatches, fmt.Sprintf("column %q is missing in the target stream", col.Name))
+		} else if normalizeColumnType(typ) != normalizeColumnType(col.Type) {
+			mismatches = append(mismatches, fmt.Sprintf("column %q is %s in the query output, but %s in the target stream", col.Name, col.Type, typ))
+		}
+	}
+	return mismatches
+}
+
+type materializedViewSettingsModel struct {
+	CheckpointInterval types.Int64  `tfsdk:"checkpoint_interval"`
+	MemoryWeight       types.Int64  `tfsdk:"memory_weight"`
+	SeekTo             types.String `tfsdk:"seek_to"`
+	PreferredExecNode  types.Int64  `tfsdk:"preferred_exec_node"`
+}
+
+func (m *materializedViewSettingsModel) toSettings() *timeplus.MaterializedViewSettings {
+	if m == nil {
+		return nil
+	}
+
+	return &timeplus.MaterializedViewSettings{
+		CheckpointInterval: int(m.CheckpointInterval.ValueInt64()),
+		MemoryWeight:       int(m.MemoryWeight.ValueInt64()),
+		SeekTo:             m.SeekTo.ValueString(),
+		PreferredExecNode:  int(m.PreferredExecNode.ValueInt64()),
+	}
+}
+
+// readStatus updates the read-only fields and `paused` from the materialized view fetched from the server.
+func (m *materializedViewResourceModel) readStatus(v timeplus.MaterializedView) {
+	m.Paused = types.BoolValue(v.Status == timeplus.MaterializedViewStatusPaused)
+	m.Status = types.StringValue(v.Status)
+	m.LastError = types.StringValue(v.LastError)
 }
 
 func (r *materializedViewResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -62,15 +127,21 @@ func (r *materializedViewResource) Schema(_ context.Context, _ resource.SchemaRe
 				MarkdownDescription: "A detailed text describes the view",
 				Optional:            true,
 			},
+			"cascade_delete": schema.BoolAttribute{
+				MarkdownDescription: "When it's `true`, the objects (e.g. views, materialized views and sinks) depend on the materialized view are deleted before the materialized view is deleted or recreated. Their definitions are read before they are deleted, and they are recreated after the materialized view is recreated in the same apply, sinks and sources can get new IDs. They are not recreated when the materialized view is only destroyed. Otherwise, deleting the materialized view fails when any object depends on it. It must be applied before the materialized view is deleted to take effect. Default: false",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"query": schema.StringAttribute{
-				MarkdownDescription: "The query SQL of the view. Changing the SQL will lead to a recreation.",
+				MarkdownDescription: "The query SQL of the view. Changing the SQL replaces the query in place, the target stream and the objects depend on the materialized view are kept. The output of the new query must be compatible with the target stream. Formatting changes (whitespaces, comments, keyword case and identifier quoting) are ignored.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					myplanmodifier.SemanticSQL(),
 				},
 			},
 			"target_stream": schema.StringAttribute{
-				MarkdownDescription: "The optional stream name that the materialized view writes data to",
+				MarkdownDescription: "The optional name of the stream, external stream or external table that the materialized view writes data to (i.e. `INTO <target_stream>`). When it's not set, the server creates an implicit target stream whose columns are the output of the query.",
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -89,6 +160,61 @@ func (r *materializedViewResource) Schema(_ context.Context, _ resource.SchemaRe
 			"history_ttl": schema.StringAttribute{
 				MarkdownDescription: "A SQL expression defines the maximum age of historical data",
 				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					myplanmodifier.SemanticSQL(),
+				},
+			},
+			"paused": schema.BoolAttribute{
+				MarkdownDescription: "When it's `true`, the materialized view is stopped and does not process any data. Setting it back to `false` resumes the materialized view from its last checkpoint. Default: false",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the materialized view, e.g. `running`, `paused` or `error`",
+				Computed:            true,
+			},
+			"last_error": schema.StringAttribute{
+				MarkdownDescription: "The last error the materialized view encountered, it's empty if there is no error",
+				Computed:            true,
+			},
+			"target_columns": columnInfoListAttribute("The columns of the target stream, either the `target_stream` or the implicit one. A warning is reported when the output of the query no longer matches them, it's checked every time the materialized view is refreshed."),
+			"columns":        columnInfoListAttribute("The output columns of the query of the materialized view"),
+		},
+
+		Blocks: map[string]schema.Block{
+			"settings": schema.SingleNestedBlock{
+				MarkdownDescription: "The settings used to create the materialized view. Changing any of the settings recreates the materialized view.",
+				Attributes: map[string]schema.Attribute{
+					"checkpoint_interval": schema.Int64Attribute{
+						MarkdownDescription: "The interval of checkpoints in seconds",
+						Optional:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.RequiresReplace(),
+						},
+					},
+					"memory_weight": schema.Int64Attribute{
+						MarkdownDescription: "The relative weight of the memory the materialized view can use, when the server is short of memory",
+						Optional:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.RequiresReplace(),
+						},
+					},
+					"seek_to": schema.StringAttribute{
+						MarkdownDescription: "Where the materialized view starts reading data when it's created, e.g. `earliest`, `latest` or a timestamp like `2024-01-01 00:00:00`",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"preferred_exec_node": schema.Int64Attribute{
+						MarkdownDescription: "The ID of the node the materialized view prefers to run on in a cluster",
+						Optional:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.RequiresReplace(),
+						},
+					},
+				},
 			},
 		},
 	}
@@ -100,18 +226,54 @@ func (r *materializedViewResource) Configure(_ context.Context, req resource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*timeplus.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *timeplus.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.provider = data
+}
+
+// ModifyPlan validates the query with the server when `validate_sql_on_plan` is enabled.
+func (r *materializedViewResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var query types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("query"), &query)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateQueryOnPlan(ctx, r.provider, req, path.Root("query"))...)
+
+	// the resource is being created
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var stateQuery types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("query"), &stateQuery)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the output columns and the columns of the implicit target stream change with the query
+	if !query.Equal(stateQuery) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("columns"), columnInfoListUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("target_columns"), columnInfoListUnknown())...)
+	}
 }
 
 func (r *materializedViewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -130,9 +292,11 @@ func (r *materializedViewResource) Create(ctx context.Context, req resource.Crea
 			Description: data.Description.ValueString(),
 			Query:       data.Query.ValueString(),
 		},
+		TargetStream:   data.TargetStream.ValueString(),
 		RetentionBytes: int(data.RetentionBytes.ValueInt64()),
 		RetentionMS:    int(data.RetentionMS.ValueInt64()),
 		TTLExpression:  data.HistoryTTL.ValueString(),
+		Settings:       data.Settings.toSettings(),
 	}
 	if err := r.client.CreateMaterializedView(&v); err != nil {
 		resp.Diagnostics.AddError("Error Creating Materialized View", fmt.Sprintf("Unable to create materialized view %q, got error: %s", v.Name, err))
@@ -143,10 +307,23 @@ func (r *materializedViewResource) Create(ctx context.Context, req resource.Crea
 	data.RetentionBytes = types.Int64Value(int64(v.RetentionBytes))
 	data.RetentionMS = types.Int64Value(int64(v.RetentionMS))
 
+	if data.Paused.ValueBool() {
+		// the materialized view is created already, it's still saved into the state and its dependents are still
+		// recreated, so that neither of them is lost
+		if err := r.client.StopMaterializedView(v.Name); err != nil {
+			resp.Diagnostics.AddError("Error Stopping Materialized View", fmt.Sprintf("Unable to stop materialized view %q, got error: %s", v.Name, err))
+		}
+	}
+
+	resp.Diagnostics.Append(r.refreshStatus(data)...)
+
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
 	tflog.Trace(ctx, "created a timeplus_materialized_view resource")
 
+	// the dependents deleted by `cascade_delete` when the old one was deleted during the replacement
+	resp.Diagnostics.Append(recreateDependents(ctx, r.provider, data.Name.ValueString())...)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -162,6 +339,11 @@ func (r *materializedViewResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	v, err := r.client.GetMaterializedView(data.Name.ValueString())
+	if timeplus.IsNotFound(err) {
+		// the materialized view is deleted outside of Terraform, e.g. by `cascade_delete` of the object it depends on
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error Reading Materialized View", fmt.Sprintf("Unable to read materialized view %q, got error: %s", data.Name.ValueString(), err))
 		return
@@ -169,7 +351,11 @@ func (r *materializedViewResource) Read(ctx context.Context, req resource.ReadRe
 
 	// required fields
 	data.Name = types.StringValue(v.Name)
-	data.Query = types.StringValue(v.Query)
+
+	// the server may format the query differently, only update it when it's actually changed
+	if !sqlnorm.Equal(data.Query.ValueString(), v.Query) {
+		data.Query = types.StringValue(v.Query)
+	}
 
 	// optional fields
 	if !(data.Description.IsNull() && v.Description == "") {
@@ -191,7 +377,52 @@ func (r *materializedViewResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	if !(data.HistoryTTL.IsNull() && v.TTLExpression == "") {
-		data.HistoryTTL = types.StringValue(v.TTLExpression)
+		if !sqlnorm.Equal(data.HistoryTTL.ValueString(), v.TTLExpression) {
+			data.HistoryTTL = types.StringValue(v.TTLExpression)
+		}
+	}
+
+	// only the settings which are set and returned by the server are checked, e.g. seek_to is not returned
+	if data.Settings != nil && v.Settings != nil {
+		if !data.Settings.CheckpointInterval.IsNull() && v.Settings.CheckpointInterval != 0 {
+			data.Settings.CheckpointInterval = types.Int64Value(int64(v.Settings.CheckpointInterval))
+		}
+		if !data.Settings.MemoryWeight.IsNull() && v.Settings.MemoryWeight != 0 {
+			data.Settings.MemoryWeight = types.Int64Value(int64(v.Settings.MemoryWeight))
+		}
+		if !data.Settings.PreferredExecNode.IsNull() && v.Settings.PreferredExecNode != 0 {
+			data.Settings.PreferredExecNode = types.Int64Value(int64(v.Settings.PreferredExecNode))
+		}
+	}
+
+	data.readStatus(v)
+
+	targetColumns, diags := columnInfoListValue(v.TargetColumns)
+	resp.Diagnostics.Append(diags...)
+	data.TargetColumns = targetColumns
+
+	columns, err := r.client.DescribeView(v.Name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Describing Materialized View", fmt.Sprintf("Unable to describe materialized view %q, got error: %s", v.Name, err))
+		return
+	}
+	data.Columns, diags = columnInfoListValue(columns)
+	resp.Diagnostics.Append(diags...)
+
+	// report the drift of the target stream schema, e.g. the target stream is altered outside of Terraform
+	if len(v.TargetColumns) > 0 {
+		if a, err := r.client.AnalyzeSQL(v.Query); err != nil {
+			tflog.Debug(ctx, "unable to analyze the query of the materialized view", map[string]any{"error": err.Error()})
+		} else if mismatches := targetSchemaMismatches(a.ResultColumns, v.TargetColumns); len(mismatches) > 0 {
+			resp.Diagnostics.AddAttributeWarning(path.Root("target_columns"), "Target Stream Schema Mismatch",
+				fmt.Sprintf("The output of the query of materialized view %q no longer matches its target stream:\n  - %s",
+					v.Name, strings.Join(mismatches, "\n  - ")))
+		}
+	}
+
+	// cascade_delete only exists in Terraform, it's null after importing
+	if data.CascadeDelete.IsNull() {
+		data.CascadeDelete = types.BoolValue(false)
 	}
 
 	// Save updated data into Terraform state
@@ -208,25 +439,90 @@ func (r *materializedViewResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	var state *materializedViewResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	v := timeplus.MaterializedView{
 		View: timeplus.View{
 			Name:        data.Name.ValueString(),
 			Description: data.Description.ValueString(),
-			// do not set `Query` as it's immutable
+			Query:       data.Query.ValueString(),
 		},
+		TargetStream:   data.TargetStream.ValueString(),
 		RetentionBytes: int(data.RetentionBytes.ValueInt64()),
 		RetentionMS:    int(data.RetentionMS.ValueInt64()),
 		TTLExpression:  data.HistoryTTL.ValueString(),
+		Settings:       data.Settings.toSettings(),
+	}
+
+	// the update API ignores the query, replace the materialized view instead of recreating it, so that the objects depend on it are kept
+	update := r.client.UpdateMaterializedView
+	if !data.Query.Equal(state.Query) {
+		update = r.client.ReplaceMaterializedView
 	}
-	if err := r.client.UpdateMaterializedView(&v); err != nil {
+
+	if err := update(&v); err != nil {
 		resp.Diagnostics.AddError("Error Updating Materialized View", fmt.Sprintf("Unable to update materialized view %q, got error: %s", v.Name, err))
 		return
 	}
 
+	// replacing the query restarts the materialized view, it needs to be stopped again
+	if !data.Paused.Equal(state.Paused) || (data.Paused.ValueBool() && !data.Query.Equal(state.Query)) {
+		if data.Paused.ValueBool() {
+			if err := r.client.StopMaterializedView(v.Name); err != nil {
+				resp.Diagnostics.AddError("Error Stopping Materialized View", fmt.Sprintf("Unable to stop materialized view %q, got error: %s", v.Name, err))
+				return
+			}
+		} else {
+			if err := r.client.StartMaterializedView(v.Name); err != nil {
+				resp.Diagnostics.AddError("Error Starting Materialized View", fmt.Sprintf("Unable to start materialized view %q, got error: %s", v.Name, err))
+				return
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(r.refreshStatus(data)...)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// refreshStatus fetches the materialized view to fill the read-only fields after it's created or updated.
+func (r *materializedViewResource) refreshStatus(data *materializedViewResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	v, err := r.client.GetMaterializedView(data.Name.ValueString())
+	if err != nil {
+		diags.AddError("Error Reading Materialized View", fmt.Sprintf("Unable to read materialized view %q, got error: %s", data.Name.ValueString(), err))
+		return diags
+	}
+
+	// keep the planned value, the status may not reflect the stop/start request yet
+	paused := data.Paused
+	data.readStatus(v)
+	data.Paused = paused
+
+	targetColumns, d := columnInfoListValue(v.TargetColumns)
+	diags.Append(d...)
+	data.TargetColumns = targetColumns
+
+	columns, err := r.client.DescribeView(v.Name)
+	if err != nil {
+		diags.AddError("Error Describing Materialized View", fmt.Sprintf("Unable to describe materialized view %q, got error: %s", v.Name, err))
+		return diags
+	}
+	data.Columns, d = columnInfoListValue(columns)
+	diags.Append(d...)
+
+	return diags
+}
+
 func (r *materializedViewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data *materializedViewResourceModel
 
@@ -237,6 +533,12 @@ func (r *materializedViewResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	resp.Diagnostics.Append(deleteDependents(ctx, r.provider, "materialized view", data.Name.ValueString(), data.CascadeDelete.ValueBool())...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.client.DeleteMaterializedView(&timeplus.MaterializedView{
 		View: timeplus.View{Name: data.Name.ValueString()},
 	})
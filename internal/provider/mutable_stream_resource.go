@@ -0,0 +1,498 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/timeplus"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &mutableStreamResource{}
+var _ resource.ResourceWithImportState = &mutableStreamResource{}
+
+func NewMutableStreamResource() resource.Resource {
+	return &mutableStreamResource{}
+}
+
+// mutableStreamResource defines the resource implementation.
+type mutableStreamResource struct {
+	client *timeplus.Client
+}
+
+type mutableStreamColumnModel struct {
+	Name    types.String `tfsdk:"name"`
+	Type    types.String `tfsdk:"type"`
+	Default types.String `tfsdk:"default"`
+	Codec   types.String `tfsdk:"codec"`
+}
+
+type mutableStreamIndexModel struct {
+	Name    types.String   `tfsdk:"name"`
+	Columns []types.String `tfsdk:"columns"`
+	Unique  types.Bool     `tfsdk:"unique"`
+}
+
+type mutableStreamFamilyModel struct {
+	Name    types.String   `tfsdk:"name"`
+	Columns []types.String `tfsdk:"columns"`
+}
+
+// mutableStreamResourceModel describes the mutable stream resource data model.
+type mutableStreamResourceModel struct {
+	Name              types.String               `tfsdk:"name"`
+	Description       types.String               `tfsdk:"description"`
+	Columns           []mutableStreamColumnModel `tfsdk:"column"`
+	PrimaryKey        []types.String             `tfsdk:"primary_key"`
+	Indexes           []mutableStreamIndexModel  `tfsdk:"index"`
+	Families          []mutableStreamFamilyModel `tfsdk:"family"`
+	Shards            types.Int64                `tfsdk:"shards"`
+	ReplicationFactor types.Int64                `tfsdk:"replication_factor"`
+	VersionColumn     types.String               `tfsdk:"version_column"`
+	RetentionBytes    types.Int64                `tfsdk:"retention_bytes"`
+	RetentionMS       types.Int64                `tfsdk:"retention_ms"`
+}
+
+func (r *mutableStreamResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mutable_stream"
+}
+
+func (r *mutableStreamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Timeplus mutable streams are streams with a primary key. Rows with the same primary key are upserted, and secondary indexes can be defined to speed up queries on non-key columns. Mutable streams are typically used as dimension tables.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The mutable stream name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A detailed text describes the mutable stream",
+				Optional:            true,
+			},
+			"primary_key": schema.ListAttribute{
+				MarkdownDescription: "The names of the columns which make up the primary key. Changing the primary key will lead to a recreation.",
+				ElementType:         types.StringType,
+				Required:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"shards": schema.Int64Attribute{
+				MarkdownDescription: "The number of shards of the mutable stream. Changing it will lead to a recreation.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"replication_factor": schema.Int64Attribute{
+				MarkdownDescription: "The number of replicas of each shard. Changing it will lead to a recreation.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"version_column": schema.StringAttribute{
+				MarkdownDescription: "The column used to decide which row wins when rows with the same primary key are upserted. When it's not set, the last written row wins.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"retention_bytes": schema.Int64Attribute{
+				MarkdownDescription: "The retention size threadhold in bytes indicates how many data could be kept in the streaming store",
+				Optional:            true,
+				Computed:            true,
+			},
+			"retention_ms": schema.Int64Attribute{
+				MarkdownDescription: "The retention period threadhold in millisecond indicates how long data could be kept in the streaming store",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"column": schema.ListNestedBlock{
+				MarkdownDescription: "Define the columns of the mutable stream. Changing the columns will lead to a recreation.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The column name",
+							Required:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type name of the column",
+							Required:            true,
+						},
+						"default": schema.StringAttribute{
+							MarkdownDescription: "The default value for the column",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+						"codec": schema.StringAttribute{
+							MarkdownDescription: "The codec for value encoding",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+					},
+				},
+			},
+			"index": schema.ListNestedBlock{
+				MarkdownDescription: "Define a secondary index of the mutable stream. Changing the indexes will lead to a recreation.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The index name",
+							Required:            true,
+						},
+						"columns": schema.ListAttribute{
+							MarkdownDescription: "The names of the indexed columns, column order matters",
+							ElementType:         types.StringType,
+							Required:            true,
+						},
+						"unique": schema.BoolAttribute{
+							MarkdownDescription: "If set to `true`, the index only accepts unique values",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"family": schema.ListNestedBlock{
+				MarkdownDescription: "Define a column family of the mutable stream. Columns in the same family are stored together, which speeds up queries reading only these columns. Changing the families will lead to a recreation.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The column family name",
+							Required:            true,
+						},
+						"columns": schema.ListAttribute{
+							MarkdownDescription: "The names of the columns belong to the family",
+							ElementType:         types.StringType,
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *mutableStreamResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *mutableStreamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *mutableStreamResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(data.Columns) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("column"), "No Columns", "At least one column must be defined for a mutable stream.")
+		return
+	}
+
+	columnNames := make(map[string]struct{}, len(data.Columns))
+	columns := make([]timeplus.Column, 0, len(data.Columns))
+	for i := range data.Columns {
+		columnNames[data.Columns[i].Name.ValueString()] = struct{}{}
+		columns = append(columns, timeplus.Column{
+			Name:    data.Columns[i].Name.ValueString(),
+			Type:    data.Columns[i].Type.ValueString(),
+			Default: data.Columns[i].Default.ValueString(),
+			Codec:   data.Columns[i].Codec.ValueString(),
+		})
+	}
+
+	// make sure all the referenced columns exist, so that users get a clear error instead of a SQL error from the server
+	checkColumns := func(p path.Path, names []types.String) bool {
+		for _, name := range names {
+			if _, ok := columnNames[name.ValueString()]; !ok {
+				resp.Diagnostics.AddAttributeError(p, "Unknown Column", fmt.Sprintf("Column %q is not defined in the mutable stream.", name.ValueString()))
+				return false
+			}
+		}
+		return true
+	}
+
+	if len(data.PrimaryKey) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("primary_key"), "No Primary Key", "At least one column must be used as the primary key of a mutable stream.")
+		return
+	}
+	if !checkColumns(path.Root("primary_key"), data.PrimaryKey) {
+		return
+	}
+
+	indexes := make([]timeplus.MutableStreamIndex, 0, len(data.Indexes))
+	for i := range data.Indexes {
+		if !checkColumns(path.Root("index").AtListIndex(i).AtName("columns"), data.Indexes[i].Columns) {
+			return
+		}
+		indexes = append(indexes, timeplus.MutableStreamIndex{
+			Name:    data.Indexes[i].Name.ValueString(),
+			Columns: stringsFrom(data.Indexes[i].Columns),
+			Unique:  data.Indexes[i].Unique.ValueBool(),
+		})
+	}
+
+	families := make([]timeplus.MutableStreamFamily, 0, len(data.Families))
+	for i := range data.Families {
+		if !checkColumns(path.Root("family").AtListIndex(i).AtName("columns"), data.Families[i].Columns) {
+			return
+		}
+		families = append(families, timeplus.MutableStreamFamily{
+			Name:    data.Families[i].Name.ValueString(),
+			Columns: stringsFrom(data.Families[i].Columns),
+		})
+	}
+
+	if !data.VersionColumn.IsNull() {
+		if !checkColumns(path.Root("version_column"), []types.String{data.VersionColumn}) {
+			return
+		}
+	}
+
+	s := timeplus.MutableStream{
+		Name:              data.Name.ValueString(),
+		Description:       data.Description.ValueString(),
+		Columns:           columns,
+		PrimaryKey:        stringsFrom(data.PrimaryKey),
+		Indexes:           indexes,
+		Families:          families,
+		Shards:            int(data.Shards.ValueInt64()),
+		ReplicationFactor: int(data.ReplicationFactor.ValueInt64()),
+		VersionColumn:     data.VersionColumn.ValueString(),
+		RetentionBytes:    int(data.RetentionBytes.ValueInt64()),
+		RetentionMS:       int(data.RetentionMS.ValueInt64()),
+	}
+
+	if err := r.client.CreateMutableStream(&s); err != nil {
+		resp.Diagnostics.AddError("Error Creating Mutable Stream", fmt.Sprintf("Unable to create mutable stream %q, got error: %s", s.Name, err))
+		return
+	}
+
+	// Computed fields
+	data.Shards = types.Int64Value(int64(s.Shards))
+	data.ReplicationFactor = types.Int64Value(int64(s.ReplicationFactor))
+	data.RetentionBytes = types.Int64Value(int64(s.RetentionBytes))
+	data.RetentionMS = types.Int64Value(int64(s.RetentionMS))
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a timeplus_mutable_stream resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *mutableStreamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *mutableStreamResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	s, err := r.client.GetMutableStream(data.Name.ValueString())
+	if timeplus.IsNotFound(err) {
+		// the mutable stream is deleted outside of Terraform, e.g. in the console
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Mutable Stream",
+			fmt.Sprintf("Unable to read mutable stream %q, got error: %s",
+				data.Name.ValueString(), err))
+		return
+	}
+
+	// required fields
+	data.Name = types.StringValue(s.Name)
+	data.PrimaryKey = stringValuesFrom(s.PrimaryKey)
+
+	// we need to handle the case that the `_tp_time` column is explicitely defined by users
+	hasTpTimeColumn := false
+	for _, col := range data.Columns {
+		if col.Name.ValueString() == "_tp_time" {
+			hasTpTimeColumn = true
+		}
+	}
+
+	data.Columns = make([]mutableStreamColumnModel, 0, len(s.Columns))
+	for i := range s.Columns {
+		name := s.Columns[i].Name
+		// if `_tp_time` is not explicitely specified in the resource definition, don't show it
+		if name == "_tp_time" && !hasTpTimeColumn {
+			continue
+		}
+
+		data.Columns = append(data.Columns, mutableStreamColumnModel{
+			Name:    types.StringValue(name),
+			Type:    types.StringValue(s.Columns[i].Type),
+			Default: types.StringValue(s.Columns[i].Default),
+			// `codec` returned by the API contains the `CODEC()` function call, like `CODEC(LZ4)`.
+			// Removing the surrounding `CODEC()` to match the input.
+			Codec: types.StringValue(strings.TrimSuffix(strings.TrimPrefix(s.Columns[i].Codec, "CODEC("), ")")),
+		})
+	}
+
+	// keep the blocks null when nothing is defined on both sides, otherwise Terraform sees a diff between null and empty
+	if !(data.Indexes == nil && len(s.Indexes) == 0) {
+		// `unique` is optional, it's kept unset unless it's set or the index is unique
+		priorUnique := make(map[string]types.Bool, len(data.Indexes))
+		for _, idx := range data.Indexes {
+			priorUnique[idx.Name.ValueString()] = idx.Unique
+		}
+
+		data.Indexes = make([]mutableStreamIndexModel, 0, len(s.Indexes))
+		for i := range s.Indexes {
+			idx := mutableStreamIndexModel{
+				Name:    types.StringValue(s.Indexes[i].Name),
+				Columns: stringValuesFrom(s.Indexes[i].Columns),
+				Unique:  types.BoolNull(),
+			}
+			if !(priorUnique[idx.Name.ValueString()].IsNull() && !s.Indexes[i].Unique) {
+				idx.Unique = types.BoolValue(s.Indexes[i].Unique)
+			}
+			data.Indexes = append(data.Indexes, idx)
+		}
+	}
+
+	if !(data.Families == nil && len(s.Families) == 0) {
+		data.Families = make([]mutableStreamFamilyModel, 0, len(s.Families))
+		for i := range s.Families {
+			data.Families = append(data.Families, mutableStreamFamilyModel{
+				Name:    types.StringValue(s.Families[i].Name),
+				Columns: stringValuesFrom(s.Families[i].Columns),
+			})
+		}
+	}
+
+	// computed fields
+	data.Shards = types.Int64Value(int64(s.Shards))
+	data.ReplicationFactor = types.Int64Value(int64(s.ReplicationFactor))
+
+	// optional fields
+	if !(data.Description.IsNull() && s.Description == "") {
+		data.Description = types.StringValue(s.Description)
+	}
+
+	if !(data.VersionColumn.IsNull() && s.VersionColumn == "") {
+		data.VersionColumn = types.StringValue(s.VersionColumn)
+	}
+
+	// the create stream API will set retention_bytes to 0 if it's not provided
+	if !(data.RetentionBytes.IsNull() && s.RetentionBytes == 0) {
+		data.RetentionBytes = types.Int64Value(int64(s.RetentionBytes))
+	}
+
+	// the create stream API will set retention_ms to 0 if it's not provided
+	if !(data.RetentionMS.IsNull() && s.RetentionMS == 0) {
+		data.RetentionMS = types.Int64Value(int64(s.RetentionMS))
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *mutableStreamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *mutableStreamResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only the fields below can be updated in place, all the other ones require a recreation
+	s := timeplus.MutableStream{
+		Name:           data.Name.ValueString(),
+		Description:    data.Description.ValueString(),
+		RetentionBytes: int(data.RetentionBytes.ValueInt64()),
+		RetentionMS:    int(data.RetentionMS.ValueInt64()),
+	}
+
+	if err := r.client.UpdateMutableStream(&s); err != nil {
+		resp.Diagnostics.AddError("Error Updating Mutable Stream", fmt.Sprintf("Unable to update mutable stream %q, got error: %s", s.Name, err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *mutableStreamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *mutableStreamResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteMutableStream(&timeplus.MutableStream{Name: data.Name.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Mutable Stream", fmt.Sprintf("Unable to delete mutable stream %q, got error: %s", data.Name.ValueString(), err))
+	}
+}
+
+func (r *mutableStreamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
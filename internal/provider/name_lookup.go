@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// lookupAttributes returns the attributes used to look up a sink, source or dashboard by either `id`, `name` or `name_regex`.
+func lookupAttributes(kind string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The %s immutable ID, generated by Timeplus. Exactly one of `id`, `name` and `name_regex` must be set", kind),
+			Optional:            true,
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The human-friendly name for the %s. When it's set, the %s is looked up by the exact name", kind, kind),
+			Optional:            true,
+			Computed:            true,
+		},
+		"name_regex": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("A regular expression (RE2 syntax) the %s is looked up by, it must match exactly one %s", kind, kind),
+			Optional:            true,
+		},
+	}
+}
+
+// validateLookup makes sure exactly one of `id`, `name` and `name_regex` is set, `name` is not empty and `name_regex` is valid.
+func validateLookup(kind string, id, name, nameRegex types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	set := 0
+	for _, v := range []types.String{id, name, nameRegex} {
+		if !v.IsNull() {
+			set++
+		}
+	}
+	if set != 1 {
+		diags.AddError(fmt.Sprintf("Invalid %s Lookup", titleCase(kind)), "Exactly one of `id`, `name` and `name_regex` must be set.")
+		return diags
+	}
+
+	if !name.IsNull() && !name.IsUnknown() && name.ValueString() == "" {
+		diags.AddAttributeError(path.Root("name"), "Invalid Name", fmt.Sprintf("The %s name can't be empty.", kind))
+	}
+
+	if !nameRegex.IsNull() && !nameRegex.IsUnknown() {
+		if _, err := regexp.Compile(nameRegex.ValueString()); err != nil {
+			diags.AddAttributeError(path.Root("name_regex"), "Invalid Name Regex", err.Error())
+		}
+	}
+	return diags
+}
+
+// findByName returns the only object whose name is `name`, or matches `nameRegex` when it's set. It reports an error
+// when nothing or more than one object matches.
+func findByName[T any](kind string, objs []T, nameOf func(T) string, name, nameRegex types.String) (T, diag.Diagnostics) {
+	var (
+		diags diag.Diagnostics
+		found T
+	)
+
+	match := func(n string) bool { return n == name.ValueString() }
+	desc := fmt.Sprintf("name %q", name.ValueString())
+	if !nameRegex.IsNull() {
+		re, err := regexp.Compile(nameRegex.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("name_regex"), "Invalid Name Regex", err.Error())
+			return found, diags
+		}
+		match = re.MatchString
+		desc = fmt.Sprintf("name regex %q", nameRegex.ValueString())
+	}
+
+	var matched []string
+	for _, obj := range objs {
+		if n := nameOf(obj); match(n) {
+			found = obj
+			matched = append(matched, n)
+		}
+	}
+
+	switch len(matched) {
+	case 0:
+		diags.AddError(fmt.Sprintf("No Matching %s", titleCase(kind)), fmt.Sprintf("No %s is found by %s.", kind, desc))
+	case 1:
+	default:
+		slices.Sort(matched)
+		diags.AddError(fmt.Sprintf("Multiple Matching %ss", titleCase(kind)), fmt.Sprintf("%d %ss are found by %s: %s. Please use a more specific name or the `id`.", len(matched), kind, desc, strings.Join(matched, ", ")))
+	}
+	return found, diags
+}
+
+// titleCase upper-cases the first letter of the kind, e.g. `sink` to `Sink`
+func titleCase(kind string) string {
+	if kind == "" {
+		return kind
+	}
+	return strings.ToUpper(kind[:1]) + kind[1:]
+}
+
+// importNamePrefix is the prefix of import IDs which refer to objects by name, e.g. `name:my_sink`
+const importNamePrefix = "name:"
+
+// resolveImportID returns the ID of the object to import. Import IDs in the form of `name:<name>` are resolved to the
+// ID of the object with exactly the name, other import IDs are the IDs themselves.
+func resolveImportID[T any](kind, importID string, list func() ([]T, error), nameOf, idOf func(T) string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	name, ok := strings.CutPrefix(importID, importNamePrefix)
+	if !ok {
+		return importID, diags
+	}
+
+	if name == "" {
+		diags.AddError("Invalid Import ID", fmt.Sprintf("The %s name is missing in the import ID %q, the expected form is `%s<name>`.", kind, importID, importNamePrefix))
+		return "", diags
+	}
+
+	objs, err := list()
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error Listing %ss", titleCase(kind)), fmt.Sprintf("Unable to list %ss, got error: %s", kind, err))
+		return "", diags
+	}
+
+	found, d := findByName(kind, objs, nameOf, types.StringValue(name), types.StringNull())
+	diags.Append(d...)
+	if diags.HasError() {
+		return "", diags
+	}
+	return idOf(found), diags
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/timeplus"
+)
+
+func TestFindByName(t *testing.T) {
+	sinks := []timeplus.Sink{
+		{ID: "1", Name: "hot_cities"},
+		{ID: "2", Name: "hot_cities_kafka"},
+		{ID: "3", Name: "cold_cities"},
+	}
+	nameOf := func(s timeplus.Sink) string { return s.Name }
+
+	null := types.StringNull()
+	cases := []struct {
+		name      string
+		byName    types.String
+		nameRegex types.String
+		wantID    string
+		wantErr   string
+	}{
+		{name: "exact", byName: types.StringValue("hot_cities"), nameRegex: null, wantID: "1"},
+		{name: "exact without partial matches", byName: types.StringValue("cities"), nameRegex: null, wantErr: "No Matching Sink"},
+		{name: "empty name is not a regex", byName: types.StringValue(""), nameRegex: null, wantErr: "No Matching Sink"},
+		{name: "regex", byName: null, nameRegex: types.StringValue("^cold_"), wantID: "3"},
+		{name: "regex matches partially", byName: null, nameRegex: types.StringValue("kafka"), wantID: "2"},
+		{name: "none", byName: null, nameRegex: types.StringValue("^warm_"), wantErr: "No Matching Sink"},
+		{name: "multiple", byName: null, nameRegex: types.StringValue("^hot_"), wantErr: "Multiple Matching Sinks"},
+		{name: "invalid regex", byName: null, nameRegex: types.StringValue("("), wantErr: "Invalid Name Regex"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			found, diags := findByName("sink", sinks, nameOf, c.byName, c.nameRegex)
+			if c.wantErr != "" {
+				if !diags.HasError() || diags[0].Summary() != c.wantErr {
+					t.Fatalf("findByName() diags = %v, want %q", diags, c.wantErr)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("findByName() diags = %v", diags)
+			}
+			if found.ID != c.wantID {
+				t.Errorf("findByName() = %s, want %s", found.ID, c.wantID)
+			}
+		})
+	}
+}
+
+func TestValidateLookup(t *testing.T) {
+	null, set := types.StringNull(), types.StringValue("hot_cities")
+
+	cases := []struct {
+		name                string
+		id, byName, byRegex types.String
+		wantErr             bool
+	}{
+		{name: "id", id: set, byName: null, byRegex: null},
+		{name: "name", id: null, byName: set, byRegex: null},
+		{name: "regex", id: null, byName: null, byRegex: types.StringValue("^hot_")},
+		{name: "unknown name", id: null, byName: types.StringUnknown(), byRegex: null},
+		{name: "none", id: null, byName: null, byRegex: null, wantErr: true},
+		{name: "empty name", id: null, byName: types.StringValue(""), byRegex: null, wantErr: true},
+		{name: "both", id: set, byName: set, byRegex: null, wantErr: true},
+		{name: "invalid regex", id: null, byName: null, byRegex: types.StringValue("("), wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if diags := validateLookup("sink", c.id, c.byName, c.byRegex); diags.HasError() != c.wantErr {
+				t.Errorf("validateLookup() diags = %v, want error %v", diags, c.wantErr)
+			}
+		})
+	}
+}
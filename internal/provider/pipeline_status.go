@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/timeplus"
+)
+
+// how long and how often fail_on_unhealthy checks the status of a sink or source after it's created or updated
+var (
+	pipelineHealthCheckTimeout  = 2 * time.Minute
+	pipelineHealthCheckInterval = 2 * time.Second
+)
+
+// pipelineStatus is the runtime status of a sink or source
+type pipelineStatus struct {
+	Status    string
+	LastError string
+	Stats     *timeplus.PipelineStats
+}
+
+// pipelineStatusFields points to the fields of the runtime status attributes (see pipelineStatusAttributes) in the
+// sink or source resource model.
+type pipelineStatusFields struct {
+	Paused          *types.Bool
+	FailOnUnhealthy *types.Bool
+	Status          *types.String
+	LastError       *types.String
+	Records         *types.Int64
+	Bytes           *types.Int64
+	FailedRecords   *types.Int64
+}
+
+// readStatus updates the read-only fields and `paused` from the status fetched from the server.
+func (m pipelineStatusFields) readStatus(s pipelineStatus) {
+	stats := s.Stats
+	if stats == nil {
+		stats = &timeplus.PipelineStats{}
+	}
+
+	*m.Paused = types.BoolValue(s.Status == timeplus.PipelineStatusPaused)
+	*m.Status = types.StringValue(s.Status)
+	*m.LastError = types.StringValue(s.LastError)
+	*m.Records = types.Int64Value(stats.Records)
+	*m.Bytes = types.Int64Value(stats.Bytes)
+	*m.FailedRecords = types.Int64Value(stats.FailedRecords)
+}
+
+// refreshStatus updates the read-only fields after the sink or source is created or updated. When `fail_on_unhealthy`
+// is enabled and it's not paused, it waits until the sink or source is running, and fails if it runs into an error or
+// does not reach the running state in time.
+func (m pipelineStatusFields) refreshStatus(ctx context.Context, kind, name string, get func() (pipelineStatus, error)) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	wait := m.FailOnUnhealthy.ValueBool() && !m.Paused.ValueBool()
+	deadline := time.Now().Add(pipelineHealthCheckTimeout)
+
+	// keep the planned value, the status may not reflect the stop/start request yet
+	paused := *m.Paused
+	defer func() { *m.Paused = paused }()
+
+	for {
+		s, err := get()
+		if err != nil {
+			// the read-only fields can't be left unknown in the state
+			if m.Status.IsUnknown() {
+				m.readStatus(pipelineStatus{})
+			}
+			diags.AddError(fmt.Sprintf("Error Reading %s", kind), fmt.Sprintf("Unable to read %s %q, got error: %s", kind, name, err))
+			return diags
+		}
+
+		m.readStatus(s)
+
+		if !wait || s.Status == timeplus.PipelineStatusRunning {
+			return diags
+		}
+
+		if s.Status == timeplus.PipelineStatusError {
+			diags.AddError(fmt.Sprintf("Unhealthy %s", kind), fmt.Sprintf("The %s %q failed to run, got error: %s", kind, name, s.LastError))
+			return diags
+		}
+
+		if time.Now().After(deadline) {
+			diags.AddError(fmt.Sprintf("Unhealthy %s", kind), fmt.Sprintf("The %s %q did not reach the running state in %s, the current status is %q.", kind, name, pipelineHealthCheckTimeout, s.Status))
+			return diags
+		}
+
+		tflog.Debug(ctx, "waiting for the pipeline to be running", map[string]any{"kind": kind, "name": name, "status": s.Status})
+
+		select {
+		case <-ctx.Done():
+			diags.AddError(fmt.Sprintf("Unhealthy %s", kind), fmt.Sprintf("Stopped waiting for the %s %q to be running: %s", kind, name, ctx.Err()))
+			return diags
+		case <-time.After(pipelineHealthCheckInterval):
+		}
+	}
+}
+
+// pipelineStatusAttributes returns the runtime status attributes shared by sinks and sources, `kind` is either sink or source.
+// The statistics are snapshots taken when the state is refreshed, they are expected to change between refreshes and
+// should not be referenced by other resources.
+func pipelineStatusAttributes(kind string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"paused": schema.BoolAttribute{
+			MarkdownDescription: fmt.Sprintf("When it's `true`, the %s is stopped. Setting it back to `false` resumes the %s. Default: false", kind, kind),
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"fail_on_unhealthy": schema.BoolAttribute{
+			MarkdownDescription: fmt.Sprintf("When it's `true`, creating or updating the %s waits until it's running, and fails if it runs into an error (e.g. authentication failures) or is not running in %s. It has no effect when the %s is paused.", kind, pipelineHealthCheckTimeout, kind),
+			Optional:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The status of the %s, e.g. `running`, `paused` or `error`", kind),
+			Computed:            true,
+		},
+		"last_error": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The last error the %s encountered, it's empty if there is no error", kind),
+			Computed:            true,
+		},
+		"records": schema.Int64Attribute{
+			MarkdownDescription: fmt.Sprintf("The number of records processed by the %s since it's started. It's a point-in-time snapshot taken when the state is refreshed, so it changes on every refresh while the %s is running, and it should not be referenced by other resources", kind, kind),
+			Computed:            true,
+		},
+		"bytes": schema.Int64Attribute{
+			MarkdownDescription: fmt.Sprintf("The number of bytes processed by the %s since it's started. It's a point-in-time snapshot taken when the state is refreshed, so it changes on every refresh while the %s is running, and it should not be referenced by other resources", kind, kind),
+			Computed:            true,
+		},
+		"failed_records": schema.Int64Attribute{
+			MarkdownDescription: fmt.Sprintf("The number of records the %s failed to process since it's started. It's a point-in-time snapshot taken when the state is refreshed, so it changes on every refresh while the %s is running, and it should not be referenced by other resources", kind, kind),
+			Computed:            true,
+		},
+	}
+}
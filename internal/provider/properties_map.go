@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// propertiesMapAttribute returns the `properties_map` attribute of sinks and sources, `kind` is either sink or source.
+//
+// The values are strings rather than dynamic values, since the plugin framework in use has no dynamic type. The types
+// of the values are decided by propertyFromString instead, a value can always be quoted to be sent as a string.
+func propertiesMapAttribute(kind string) schema.MapAttribute {
+	return schema.MapAttribute{
+		MarkdownDescription: fmt.Sprintf("The configurations for the specific %s type as a map, it's an alternative to `properties`. The values are coerced: values which are valid JSON numbers, booleans, objects or arrays (e.g. `true`, `8080`, `jsonencode({...})`) are sent as such, JSON strings (e.g. `jsonencode(\"8080\")`, which is `\"\\\"8080\\\"\"`) are sent as the strings they contain, and all other values are sent as strings. Thus, a plain `\"8080\"` or `\"true\"` is sent as a number or a boolean, wrap such values with `jsonencode()` to send them as strings, e.g. `port = jsonencode(\"8080\")`. Changes of the non-secret keys are shown per key in `redacted_properties`.", kind),
+		ElementType:         types.StringType,
+		Optional:            true,
+		Sensitive:           true,
+	}
+}
+
+// propertiesFromMap converts the `properties_map` to the properties sent to the API, see propertyFromString
+func propertiesFromMap(m types.Map) map[string]any {
+	props := make(map[string]any, len(m.Elements()))
+	for k, v := range m.Elements() {
+		if s, ok := v.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+			props[k] = propertyFromString(s.ValueString())
+		}
+	}
+	return props
+}
+
+// propertiesMapKnown reports whether the `properties_map` and all its values are known
+func propertiesMapKnown(m types.Map) bool {
+	if m.IsUnknown() {
+		return false
+	}
+	for _, v := range m.Elements() {
+		if v.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
+
+// propertiesMapValue converts the properties returned by the API to the `properties_map`, it's the reverse of propertiesFromMap
+func propertiesMapValue(props map[string]any) types.Map {
+	elems := make(map[string]attr.Value, len(props))
+	for k, v := range props {
+		elems[k] = types.StringValue(propertyToString(v))
+	}
+	return types.MapValueMust(types.StringType, elems)
+}
+
+// propertyFromString decodes the value if it's a JSON number, boolean, string, object or array, otherwise the value is a
+// string. A JSON string is decoded to the string it contains, so that users can quote values to keep them strings.
+func propertyFromString(s string) any {
+	trimmed := strings.TrimSpace(s)
+	if trimmed == "" || trimmed == "null" {
+		return s
+	}
+
+	var v any
+	if err := json.Unmarshal([]byte(trimmed), &v); err != nil {
+		return s
+	}
+	return v
+}
+
+// propertyToString encodes the value into the string form used by propertyFromString.
+func propertyToString(v any) string {
+	if s, ok := v.(string); ok {
+		// the string would be decoded into another type, it needs to be quoted
+		if _, ok := propertyFromString(s).(string); !ok {
+			b, _ := json.Marshal(s)
+			return string(b)
+		}
+		return s
+	}
+
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+// mergePropertiesMap merges the properties returned by the API into the `properties_map` in the state. Values which
+// are equal after decoding keep their original form, secrets are kept like mergeProperties does.
+func mergePropertiesMap(state types.Map, server map[string]any, isSecret func(key string) bool) types.Map {
+	elems := make(map[string]attr.Value, len(state.Elements())+len(server))
+	for k, v := range state.Elements() {
+		elems[k] = v
+	}
+
+	for k, v := range server {
+		if isSecret(k) && (v == nil || isRedactionMarker(v)) {
+			continue
+		}
+
+		if s, ok := elems[k].(types.String); ok && !s.IsNull() && !s.IsUnknown() && jsonEqual(propertyFromString(s.ValueString()), v) {
+			continue
+		}
+		elems[k] = types.StringValue(propertyToString(v))
+	}
+
+	return types.MapValueMust(types.StringType, elems)
+}
+
+// jsonEqual reports whether two values decoded from JSON are the same
+func jsonEqual(a, b any) bool {
+	ja, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	jb, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return string(ja) == string(jb)
+}
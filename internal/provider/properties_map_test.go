@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "testing"
+
+func TestPropertyFromString(t *testing.T) {
+	cases := []struct {
+		in   string
+		want any
+	}{
+		{in: "localhost:9092", want: "localhost:9092"},
+		{in: "8080", want: float64(8080)},
+		{in: "true", want: true},
+		{in: `"8080"`, want: "8080"},
+		{in: `"true"`, want: "true"},
+		{in: "", want: ""},
+		{in: "null", want: "null"},
+		{in: `{"a":1}`, want: map[string]any{"a": float64(1)}},
+		{in: `[1,"b"]`, want: []any{float64(1), "b"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			got := propertyFromString(c.in)
+			if !jsonEqual(got, c.want) {
+				t.Errorf("propertyFromString(%q) = %#v, want %#v", c.in, got, c.want)
+			}
+			if _, ok := c.want.(string); ok {
+				if _, ok := got.(string); !ok {
+					t.Errorf("propertyFromString(%q) = %#v, want a string", c.in, got)
+				}
+			}
+
+			// the string form decodes to the same value
+			if back := propertyFromString(propertyToString(got)); !jsonEqual(back, got) {
+				t.Errorf("propertyFromString(propertyToString(%#v)) = %#v", got, back)
+			}
+		})
+	}
+
+	// strings which would be decoded into other types are quoted
+	for in, want := range map[string]string{"8080": `"8080"`, "true": `"true"`, "a:b": "a:b"} {
+		if got := propertyToString(in); got != want {
+			t.Errorf("propertyToString(%q) = %s, want %s", in, got, want)
+		}
+	}
+}
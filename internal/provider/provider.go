@@ -32,6 +32,19 @@ type TimeplusProviderModel struct {
 	Endpoint  types.String `tfsdk:"endpoint"`
 	Username  types.String `tfsdk:"username"`
 	Password  types.String `tfsdk:"password"`
+
+	ValidateSQLOnPlan types.Bool `tfsdk:"validate_sql_on_plan"`
+}
+
+// providerData is passed to the resources, it holds the client and the provider settings of the resources' behaviors.
+type providerData struct {
+	client *timeplus.Client
+
+	// when it's true, the queries of views, materialized views and sinks are analyzed by the server during planning
+	validateSQLOnPlan bool
+
+	// the dependents deleted by `cascade_delete`, which are recreated after the objects they depend on are recreated
+	dependents *dependentStore
 }
 
 func (p *TimeplusProvider) Metadata(ctx context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -60,6 +73,10 @@ Use the navigation to the left to read about the available resources.`,
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"validate_sql_on_plan": schema.BoolAttribute{
+				MarkdownDescription: "When it's set to `true`, the queries of views, materialized views and sinks are analyzed by the Timeplus server during planning, so that invalid queries are reported before anything is applied. Queries refer to streams, views or functions which don't exist yet, and queries of parameterized views, are not validated. Default to `false`.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -83,18 +100,28 @@ func (p *TimeplusProvider) Configure(ctx context.Context, req provider.Configure
 	}
 
 	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.ResourceData = &providerData{
+		client:            client,
+		validateSQLOnPlan: data.ValidateSQLOnPlan.ValueBool(),
+		dependents:        newDependentStore(),
+	}
 }
 
 func (p *TimeplusProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewStreamResource,
+		NewMutableStreamResource,
+		NewRandomStreamResource,
+		NewExternalTableResource,
+		NewDictionaryResource,
+		NewFormatSchemaResource,
 		NewViewResource,
 		NewMaterializedViewResource,
 		NewSinkResource,
 		NewSourceResource,
 		NewRemoteFunctionResource,
 		NewJavascriptFunctionResource,
+		NewPythonFunctionResource,
 		NewDashboardResource,
 	}
 }
@@ -102,13 +129,23 @@ func (p *TimeplusProvider) Resources(ctx context.Context) []func() resource.Reso
 func (p *TimeplusProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewStreamDataSource,
+		NewDictionaryDataSource,
 		NewViewDataSource,
 		NewMaterializedViewDataSource,
 		NewSinkDataSource,
 		NewSourceDataSource,
 		NewRemoteFunctionDataSource,
 		NewJavascriptFunctionDataSource,
+		NewPythonFunctionDataSource,
 		NewDashboardDataSource,
+		NewLineageDataSource,
+		NewStreamsDataSource,
+		NewViewsDataSource,
+		NewMaterializedViewsDataSource,
+		NewSinksDataSource,
+		NewSourcesDataSource,
+		NewFunctionsDataSource,
+		NewDashboardsDataSource,
 	}
 }
 
@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/timeplus"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &pythonFunctionDataSource{}
+
+func NewPythonFunctionDataSource() datasource.DataSource {
+	return &pythonFunctionDataSource{}
+}
+
+// pythonFunctionDataSource defines the data source implementation.
+type pythonFunctionDataSource struct {
+	client *timeplus.Client
+}
+
+// pythonFunctionDataSourceModel describes the data source data model.
+type pythonFunctionDataSourceModel struct {
+	Name           types.String            `tfsdk:"name"`
+	Description    types.String            `tfsdk:"description"`
+	Arguments      []functionArgumentModel `tfsdk:"arg"`
+	ReturnType     types.String            `tfsdk:"return_type"`
+	Source         types.String            `tfsdk:"source"`
+	IsAggrFunction types.Bool              `tfsdk:"is_aggregate_function"`
+	Requirements   []types.String          `tfsdk:"requirements"`
+}
+
+func (d *pythonFunctionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_python_function"
+}
+
+func (d *pythonFunctionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Timeplus python functions are one of the supported user defined function types. Python functions allow users to implement functions with the python programming language, and be called in queries. Python functions are only available in Timeplus Enterprise.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The python function name",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A detailed text describes the python function",
+				Computed:            true,
+			},
+			"is_aggregate_function": schema.BoolAttribute{
+				MarkdownDescription: "Indicates if the python function is an aggregate function",
+				Computed:            true,
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "The python function source code",
+				Computed:            true,
+			},
+			"return_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the function's return value",
+				Computed:            true,
+			},
+			"requirements": schema.ListAttribute{
+				MarkdownDescription: "The pip requirement specifiers of the packages the python function imports",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"arg": schema.ListNestedAttribute{
+				MarkdownDescription: "The argument names and types the python function takes",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The argument name",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The argument type",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *pythonFunctionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*timeplus.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *timeplus.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *pythonFunctionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *pythonFunctionDataSourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	s, err := d.client.GetUDF(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading PythonFunction",
+			fmt.Sprintf("Unable to read python function %q, got error: %s",
+				data.Name.ValueString(), err))
+		return
+	}
+
+	if s.Type != timeplus.UDFTypePython {
+		resp.Diagnostics.AddError(
+			"Error Reading PythonFunction",
+			fmt.Sprintf("Function with name %s is not a python function",
+				data.Name.ValueString()))
+		return
+	}
+
+	// required fields
+	data.Name = types.StringValue(s.Name)
+	data.IsAggrFunction = types.BoolValue(s.IsAggrFunction)
+	data.Source = types.StringValue(s.Source)
+	data.ReturnType = types.StringValue(s.ReturnType)
+	data.Requirements = stringValuesFrom(s.Requirements)
+
+	// optional fields
+	data.Arguments = make([]functionArgumentModel, 0, len(s.Arguments))
+	for i := range s.Arguments {
+		data.Arguments = append(data.Arguments, functionArgumentModel{
+			Name: types.StringValue(s.Arguments[i].Name),
+			Type: types.StringValue(s.Arguments[i].Type),
+		})
+	}
+
+	if !(data.Description.IsNull() && s.Description == "") {
+		data.Description = types.StringValue(s.Description)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,276 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/timeplus"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &pythonFunctionResource{}
+var _ resource.ResourceWithImportState = &pythonFunctionResource{}
+
+func NewPythonFunctionResource() resource.Resource {
+	return &pythonFunctionResource{}
+}
+
+// pythonFunctionResource defines the resource implementation.
+type pythonFunctionResource struct {
+	client *timeplus.Client
+}
+
+// pythonFunctionResourceModel describes the python function resource data model.
+type pythonFunctionResourceModel struct {
+	Name           types.String            `tfsdk:"name"`
+	Description    types.String            `tfsdk:"description"`
+	Arguments      []functionArgumentModel `tfsdk:"arg"`
+	ReturnType     types.String            `tfsdk:"return_type"`
+	Source         types.String            `tfsdk:"source"`
+	IsAggrFunction types.Bool              `tfsdk:"is_aggregate_function"`
+	Requirements   []types.String          `tfsdk:"requirements"`
+}
+
+func (r *pythonFunctionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_python_function"
+}
+
+func (r *pythonFunctionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Timeplus python functions are one of the supported user defined function types. Python functions allow users to implement functions with the python programming language, and be called in queries. Python functions are only available in Timeplus Enterprise.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The python function name, the source must define a python function with the same name",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A detailed text describes the python function",
+				Optional:            true,
+			},
+			"is_aggregate_function": schema.BoolAttribute{
+				MarkdownDescription: "Indicates if the python function is an aggregate function",
+				Optional:            true,
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "The python function source code",
+				Required:            true,
+			},
+			"return_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the function's return value",
+				Required:            true,
+			},
+			"requirements": schema.ListAttribute{
+				MarkdownDescription: "The pip requirement specifiers of the packages the python function imports, e.g. `numpy==1.26.4`. The packages are installed by Timeplus before the function is created",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"arg": schema.ListNestedBlock{
+				MarkdownDescription: "Describe an argument of the python function, argument order matters",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The argument name",
+							Required:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The argument type",
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *pythonFunctionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *pythonFunctionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *pythonFunctionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := make([]timeplus.UDFArgument, 0, len(data.Arguments))
+	for i := range data.Arguments {
+		args = append(args, timeplus.UDFArgument{
+			Name: data.Arguments[i].Name.ValueString(),
+			Type: data.Arguments[i].Type.ValueString(),
+		})
+	}
+
+	f := timeplus.UDF{
+		Name:           data.Name.ValueString(),
+		Description:    data.Description.ValueString(),
+		Type:           timeplus.UDFTypePython,
+		Arguments:      args,
+		ReturnType:     data.ReturnType.ValueString(),
+		IsAggrFunction: data.IsAggrFunction.ValueBool(),
+		Source:         data.Source.ValueString(),
+		Requirements:   stringsFrom(data.Requirements),
+	}
+	if err := r.client.CreateUDF(&f); err != nil {
+		resp.Diagnostics.AddError("Error Creating PythonFunction", fmt.Sprintf("Unable to create python function %q, got error: %s", f.Name, err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a timeplus_python_function resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *pythonFunctionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *pythonFunctionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	s, err := r.client.GetUDF(data.Name.ValueString())
+	if timeplus.IsNotFound(err) {
+		// the python function is deleted outside of Terraform, e.g. in the console
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading PythonFunction",
+			fmt.Sprintf("Unable to read python function %q, got error: %s",
+				data.Name.ValueString(), err))
+		return
+	}
+
+	if s.Type != timeplus.UDFTypePython {
+		resp.Diagnostics.AddError(
+			"Error Reading PythonFunction",
+			fmt.Sprintf("Function with name %s is not a python function",
+				data.Name.ValueString()))
+		return
+	}
+
+	// required fields
+	data.Name = types.StringValue(s.Name)
+	data.Source = types.StringValue(s.Source)
+	data.ReturnType = types.StringValue(s.ReturnType)
+
+	// optional fields
+	if !(data.IsAggrFunction.IsNull() && !s.IsAggrFunction) {
+		data.IsAggrFunction = types.BoolValue(s.IsAggrFunction)
+	}
+
+	if !(data.Description.IsNull() && s.Description == "") {
+		data.Description = types.StringValue(s.Description)
+	}
+
+	if !(data.Requirements == nil && len(s.Requirements) == 0) {
+		data.Requirements = stringValuesFrom(s.Requirements)
+	}
+
+	data.Arguments = make([]functionArgumentModel, 0, len(s.Arguments))
+	for i := range s.Arguments {
+		data.Arguments = append(data.Arguments, functionArgumentModel{
+			Name: types.StringValue(s.Arguments[i].Name),
+			Type: types.StringValue(s.Arguments[i].Type),
+		})
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *pythonFunctionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *pythonFunctionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := make([]timeplus.UDFArgument, 0, len(data.Arguments))
+	for i := range data.Arguments {
+		args = append(args, timeplus.UDFArgument{
+			Name: data.Arguments[i].Name.ValueString(),
+			Type: data.Arguments[i].Type.ValueString(),
+		})
+	}
+
+	f := timeplus.UDF{
+		Name:           data.Name.ValueString(),
+		Description:    data.Description.ValueString(),
+		Type:           timeplus.UDFTypePython,
+		Arguments:      args,
+		ReturnType:     data.ReturnType.ValueString(),
+		IsAggrFunction: data.IsAggrFunction.ValueBool(),
+		Source:         data.Source.ValueString(),
+		Requirements:   stringsFrom(data.Requirements),
+	}
+	if err := r.client.UpdateUDF(&f); err != nil {
+		resp.Diagnostics.AddError("Error Updating PythonFunction", fmt.Sprintf("Unable to update python function %q, got error: %s", f.Name, err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *pythonFunctionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *pythonFunctionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteUDF(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting PythonFunction", fmt.Sprintf("Unable to delete python function %q, got error: %s", data.Name.ValueString(), err))
+	}
+}
+
+func (r *pythonFunctionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
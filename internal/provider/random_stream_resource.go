@@ -0,0 +1,283 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/timeplus"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &randomStreamResource{}
+var _ resource.ResourceWithImportState = &randomStreamResource{}
+
+func NewRandomStreamResource() resource.Resource {
+	return &randomStreamResource{}
+}
+
+// randomStreamResource defines the resource implementation.
+type randomStreamResource struct {
+	client *timeplus.Client
+}
+
+// randomStreamResourceModel describes the random stream resource data model.
+type randomStreamResourceModel struct {
+	Name            types.String  `tfsdk:"name"`
+	Description     types.String  `tfsdk:"description"`
+	Columns         []columnModel `tfsdk:"column"`
+	EventsPerSecond types.Float64 `tfsdk:"eps"`
+}
+
+func (r *randomStreamResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_random_stream"
+}
+
+func (r *randomStreamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Timeplus random streams generate random data continuously, they are handy for load tests and demos. The `default` expression of a column is used to generate the values of the column, columns without `default` get random values of their types. The `column` block is the same as the one of `timeplus_stream`, so a random stream can be turned into a real stream by changing the resource type.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The random stream name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A detailed text describes the random stream",
+				Optional:            true,
+			},
+			"eps": schema.Float64Attribute{
+				MarkdownDescription: "How many events are generated per second, fractional values like `0.5` (i.e. one event per two seconds) are allowed. Default: 1000",
+				Optional:            true,
+				Computed:            true,
+				Default:             float64default.StaticFloat64(1000),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"column": schema.ListNestedBlock{
+				MarkdownDescription: "Define the columns of the random stream. Use `default` to define how the values are generated, e.g. `rand() % 100`. Changing the columns will lead to a recreation. `primary_key` is ignored for random streams.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: columnBlockObject(),
+			},
+		},
+	}
+}
+
+func (r *randomStreamResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *randomStreamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *randomStreamResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(data.Columns) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("column"), "No Columns", "At least one column must be defined for a random stream.")
+		return
+	}
+
+	eventTimeColumn := ""
+	columns := make([]timeplus.Column, 0, len(data.Columns))
+	for i := range data.Columns {
+		if data.Columns[i].UseAsEventTime.ValueBool() {
+			if eventTimeColumn != "" {
+				resp.Diagnostics.AddAttributeError(path.Root(fmt.Sprintf("column[%d]", i)), "Too Many EventTime Columns", "Only one column can be marked as event time column.")
+				return
+			}
+			eventTimeColumn = data.Columns[i].Name.ValueString()
+		}
+		if data.Columns[i].PrimaryKey.ValueBool() {
+			resp.Diagnostics.AddAttributeWarning(path.Root(fmt.Sprintf("column[%d]", i)), "Primary Key Ignored", "Random streams do not support primary keys, `primary_key` is ignored.")
+		}
+		columns = append(columns, timeplus.Column{
+			Name:    data.Columns[i].Name.ValueString(),
+			Type:    data.Columns[i].Type.ValueString(),
+			Default: data.Columns[i].Default.ValueString(),
+			Codec:   data.Columns[i].Codec.ValueString(),
+		})
+	}
+
+	s := timeplus.RandomStream{
+		Name:            data.Name.ValueString(),
+		Description:     data.Description.ValueString(),
+		Columns:         columns,
+		EventTimeColumn: eventTimeColumn,
+		EventsPerSecond: data.EventsPerSecond.ValueFloat64(),
+	}
+
+	if err := r.client.CreateRandomStream(&s); err != nil {
+		resp.Diagnostics.AddError("Error Creating Random Stream", fmt.Sprintf("Unable to create random stream %q, got error: %s", s.Name, err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a timeplus_random_stream resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *randomStreamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *randomStreamResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	s, err := r.client.GetRandomStream(data.Name.ValueString())
+	if timeplus.IsNotFound(err) {
+		// the random stream is deleted outside of Terraform, e.g. in the console
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Random Stream",
+			fmt.Sprintf("Unable to read random stream %q, got error: %s",
+				data.Name.ValueString(), err))
+		return
+	}
+
+	// required fields
+	data.Name = types.StringValue(s.Name)
+
+	// we need to handle the case that the `_tp_time` column is explicitely defined by users
+	hasTpTimeColumn := false
+	// the column lookup map
+	dataColumns := make(map[string]columnModel, len(data.Columns))
+	for _, col := range data.Columns {
+		name := col.Name.ValueString()
+
+		if name == "_tp_time" {
+			hasTpTimeColumn = true
+		}
+
+		dataColumns[name] = col
+	}
+
+	data.Columns = make([]columnModel, 0, len(s.Columns))
+	for i := range s.Columns {
+		name := s.Columns[i].Name
+		// if `_tp_time` is not explicitely specified in the resource definition, don't show it
+		if name == "_tp_time" && !hasTpTimeColumn {
+			continue
+		}
+
+		col := columnModel{
+			Name:    types.StringValue(name),
+			Type:    types.StringValue(s.Columns[i].Type),
+			Default: types.StringValue(s.Columns[i].Default),
+			// `codec` returned by the API contains the `CODEC()` function call, like `CODEC(LZ4)`.
+			// Removing the surrounding `CODEC()` to match the input.
+			Codec: types.StringValue(strings.TrimSuffix(strings.TrimPrefix(s.Columns[i].Codec, "CODEC("), ")")),
+		}
+
+		// random streams have no primary keys, keep whatever users defined so that the ignored flag does not cause diffs
+		if dataColumn, ok := dataColumns[name]; ok {
+			col.UseAsEventTime = dataColumn.UseAsEventTime
+			col.PrimaryKey = dataColumn.PrimaryKey
+		}
+
+		data.Columns = append(data.Columns, col)
+	}
+
+	// optional fields
+	if !(data.Description.IsNull() && s.Description == "") {
+		data.Description = types.StringValue(s.Description)
+	}
+
+	data.EventsPerSecond = types.Float64Value(s.EventsPerSecond)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *randomStreamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *randomStreamResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// columns changes require a recreation, only `description` and `eps` can be updated in place
+	s := timeplus.RandomStream{
+		Name:            data.Name.ValueString(),
+		Description:     data.Description.ValueString(),
+		EventsPerSecond: data.EventsPerSecond.ValueFloat64(),
+	}
+
+	if err := r.client.UpdateRandomStream(&s); err != nil {
+		resp.Diagnostics.AddError("Error Updating Random Stream", fmt.Sprintf("Unable to update random stream %q, got error: %s", s.Name, err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *randomStreamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *randomStreamResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRandomStream(&timeplus.RandomStream{Name: data.Name.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Random Stream", fmt.Sprintf("Unable to delete random stream %q, got error: %s", data.Name.ValueString(), err))
+	}
+}
+
+func (r *randomStreamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
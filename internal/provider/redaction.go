@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+)
+
+// commonSecretKeys are the property keys treated as secrets for all sink and source types
+var commonSecretKeys = map[string]bool{
+	"password":          true,
+	"secret":            true,
+	"token":             true,
+	"api_key":           true,
+	"apikey":            true,
+	"access_token":      true,
+	"client_secret":     true,
+	"private_key":       true,
+	"secret_access_key": true,
+	"sasl_password":     true,
+}
+
+// secretKeyMatcher returns a function reports whether a property key is a secret, either it's one of the
+// commonSecretKeys, or it's sensitive in the typed properties of the sink or source type.
+func secretKeyMatcher(props typedProperties) func(key string) bool {
+	secrets := make(map[string]bool, len(props))
+	for _, p := range props {
+		if p.sensitive {
+			// nested properties are redacted by their own keys
+			secrets[p.key[strings.LastIndex(p.key, ".")+1:]] = true
+		}
+	}
+
+	return func(key string) bool {
+		key = strings.ToLower(key)
+		if commonSecretKeys[key] || secrets[key] {
+			return true
+		}
+		return strings.HasSuffix(key, "_password") || strings.HasSuffix(key, "_secret") || strings.HasSuffix(key, "_token")
+	}
+}
+
+// isRedactionMarker reports whether the value returned by the server is a placeholder of a secret, e.g. `******`
+func isRedactionMarker(v any) bool {
+	s, ok := v.(string)
+	if !ok {
+		return false
+	}
+
+	if len(s) >= 3 && strings.Trim(s, "*") == "" {
+		return true
+	}
+
+	switch strings.ToLower(s) {
+	case "redacted", "<redacted>", "[redacted]", "<hidden>":
+		return true
+	}
+	return false
+}
+
+const saltedHashPrefix = "sha256:"
+
+// redactProperties returns a copy of the properties whose secret values are replaced by salted hashes, so that the
+// result can be shown in plans and kept in the state. The salts are reused from `prior` (a previous result), so that a
+// hash only changes when the secret changes. Secrets without a prior hash get salts derived from `seed` and their key
+// paths, the result must be the same when the plan is made and when it's applied.
+func redactProperties(props, prior map[string]any, seed, keyPath string, isSecret func(key string) bool) map[string]any {
+	redacted := make(map[string]any, len(props))
+	for k, v := range props {
+		p := k
+		if keyPath != "" {
+			p = keyPath + "." + k
+		}
+
+		if isSecret(k) {
+			salt, ok := saltOf(prior[k])
+			if !ok {
+				salt = deriveSalt(seed, p)
+			}
+			redacted[k] = saltedHash(v, salt)
+			continue
+		}
+
+		if m, ok := v.(map[string]any); ok {
+			pm, _ := prior[k].(map[string]any)
+			redacted[k] = redactProperties(m, pm, seed, p, isSecret)
+			continue
+		}
+
+		redacted[k] = v
+	}
+	return redacted
+}
+
+// redactPropertiesJSON is redactProperties for the JSON kept in the state, `seed` identifies the sink or source,
+// e.g. `sink/my_sink`.
+func redactPropertiesJSON(props map[string]any, prior, seed string, isSecret func(key string) bool) (string, error) {
+	priorProps := map[string]any{}
+	if prior != "" {
+		// a broken prior value only means new salts are used
+		_ = json.Unmarshal([]byte(prior), &priorProps)
+	}
+
+	b, err := json.Marshal(redactProperties(props, priorProps, seed, "", isSecret))
+	return string(b), err
+}
+
+// saltOf returns the salt of a salted hash, it reports false if the value is not a salted hash.
+func saltOf(v any) (string, bool) {
+	if s, ok := v.(string); ok && strings.HasPrefix(s, saltedHashPrefix) {
+		if salt, _, ok := strings.Cut(strings.TrimPrefix(s, saltedHashPrefix), ":"); ok && salt != "" {
+			return salt, true
+		}
+	}
+	return "", false
+}
+
+// deriveSalt returns the salt of a secret which does not have a prior hash. It's an HMAC of the key path keyed by
+// `seed`, so that it's stable across the plan and apply, and differs between sinks and sources.
+func deriveSalt(seed, keyPath string) string {
+	mac := hmac.New(sha256.New, []byte(seed))
+	mac.Write([]byte(keyPath))
+	return hex.EncodeToString(mac.Sum(nil)[:8])
+}
+
+// saltedHash returns `sha256:<salt>:<hash>` of the value
+func saltedHash(v any, salt string) string {
+	b, _ := json.Marshal(v)
+	sum := sha256.Sum256(append([]byte(salt), b...))
+	return saltedHashPrefix + salt + ":" + hex.EncodeToString(sum[:])
+}
+
+// mergeProperties merges the properties returned by the API into the properties (JSON) in the state, and reports
+// whether anything is changed. Secrets in the state are kept, since the API does not return them, or returns
+// redaction markers instead.
+func mergeProperties(ctx context.Context, state string, server map[string]any, isSecret func(key string) bool) (map[string]any, bool) {
+	props := make(map[string]any)
+	if state != "" {
+		_ = json.Unmarshal([]byte(state), &props)
+	}
+
+	// the JSON encoding of maps is sorted by keys, thus it can be used to compare nested maps
+	before, _ := json.Marshal(props)
+	deepCopyMap(ctx, props, server, isSecret)
+	after, _ := json.Marshal(props)
+
+	return props, string(before) != string(after)
+}
+
+// deepCopyMap copies the values of src into dst recursively, except the secrets which src does not have real values of.
+func deepCopyMap(ctx context.Context, dst, src map[string]any, isSecret func(key string) bool) {
+	for k, v := range src {
+		if isSecret(k) && (v == nil || isRedactionMarker(v)) {
+			continue
+		}
+
+		dv, ok := dst[k]
+		if !ok || dv == nil {
+			dst[k] = v
+			continue
+		}
+
+		dm, ok := dv.(map[string]any)
+		if !ok {
+			dst[k] = v
+			continue
+		}
+
+		sm, ok := v.(map[string]any)
+		if !ok {
+			dst[k] = v
+			continue
+		}
+
+		deepCopyMap(ctx, dm, sm, isSecret)
+	}
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestRedactPropertiesJSON(t *testing.T) {
+	isSecret := secretKeyMatcher(sinkTypes["kafka"])
+	props := map[string]any{
+		"brokers":  "localhost:9092",
+		"password": "s3cret",
+		"auth":     map[string]any{"access_token": "key"},
+	}
+
+	// the plan and the apply both start without a prior value, they must agree
+	first, err := redactPropertiesJSON(props, "", "sink/my_sink", isSecret)
+	if err != nil {
+		t.Fatalf("redactPropertiesJSON() error = %v", err)
+	}
+	second, err := redactPropertiesJSON(props, "", "sink/my_sink", isSecret)
+	if err != nil {
+		t.Fatalf("redactPropertiesJSON() error = %v", err)
+	}
+	if first != second {
+		t.Errorf("redactPropertiesJSON() is not deterministic: %s != %s", first, second)
+	}
+	if strings.Contains(first, "s3cret") || strings.Contains(first, `"key"`) {
+		t.Errorf("redactPropertiesJSON() = %s, secrets are not redacted", first)
+	}
+	if !strings.Contains(first, "localhost:9092") {
+		t.Errorf("redactPropertiesJSON() = %s, non-secret properties are redacted", first)
+	}
+
+	other, _ := redactPropertiesJSON(props, "", "sink/other_sink", isSecret)
+	if other == first {
+		t.Errorf("redactPropertiesJSON() = %s for different sinks, want different salts", other)
+	}
+
+	// salts of the prior value are kept, the hash only changes with the secret
+	prior := `{"password":"sha256:0011223344556677:abc"}`
+	redacted, _ := redactPropertiesJSON(props, prior, "sink/my_sink", isSecret)
+	var m map[string]any
+	if err := json.Unmarshal([]byte(redacted), &m); err != nil {
+		t.Fatalf("invalid JSON %s: %v", redacted, err)
+	}
+	if got := m["password"].(string); !strings.HasPrefix(got, "sha256:0011223344556677:") {
+		t.Errorf("password = %s, want the prior salt", got)
+	}
+	if got := m["password"]; got != saltedHash("s3cret", "0011223344556677") {
+		t.Errorf("password = %s, want the hash of the secret", got)
+	}
+}
+
+func TestMergeProperties(t *testing.T) {
+	isSecret := secretKeyMatcher(nil)
+	state := `{"brokers":"a:9092","password":"s3cret","nested":{"token":"t","x":1}}`
+
+	cases := []struct {
+		name        string
+		server      map[string]any
+		wantChanged bool
+		want        string
+	}{
+		{
+			name:   "redaction markers keep secrets",
+			server: map[string]any{"brokers": "a:9092", "password": "******", "nested": map[string]any{"token": nil, "x": float64(1)}},
+			want:   state,
+		},
+		{
+			name:        "changed non-secret",
+			server:      map[string]any{"brokers": "b:9092", "password": "******"},
+			wantChanged: true,
+			want:        `{"brokers":"b:9092","password":"s3cret","nested":{"token":"t","x":1}}`,
+		},
+		{
+			name:        "changed secret",
+			server:      map[string]any{"password": "new"},
+			wantChanged: true,
+			want:        `{"brokers":"a:9092","password":"new","nested":{"token":"t","x":1}}`,
+		},
+		{
+			name:        "nested change",
+			server:      map[string]any{"nested": map[string]any{"x": float64(2), "y": "z"}},
+			wantChanged: true,
+			want:        `{"brokers":"a:9092","password":"s3cret","nested":{"token":"t","x":2,"y":"z"}}`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, changed := mergeProperties(context.Background(), state, c.server, isSecret)
+			if changed != c.wantChanged {
+				t.Errorf("mergeProperties() changed = %v, want %v", changed, c.wantChanged)
+			}
+			var want map[string]any
+			_ = json.Unmarshal([]byte(c.want), &want)
+			if !jsonEqual(got, want) {
+				t.Errorf("mergeProperties() = %s, want %s", jsonString(got), c.want)
+			}
+		})
+	}
+}
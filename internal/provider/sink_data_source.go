@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -16,6 +17,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &sinkDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &sinkDataSource{}
 
 func NewSinkDataSource() datasource.DataSource {
 	return &sinkDataSource{}
@@ -27,7 +29,15 @@ type sinkDataSource struct {
 }
 
 // sinkDataSourceModel describes the data source data model.
-type sinkDataSourceModel sinkResourceModel
+type sinkDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	NameRegex   types.String `tfsdk:"name_regex"`
+	Description types.String `tfsdk:"description"`
+	Query       types.String `tfsdk:"query"`
+	Type        types.String `tfsdk:"type"`
+	Properties  types.String `tfsdk:"properties"`
+}
 
 func (d *sinkDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_sink"
@@ -39,14 +49,6 @@ func (d *sinkDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 		MarkdownDescription: "Timeplus sinks run queries in background and send query results to the target system continuously.",
 
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "The sink immutable ID, generated by Timeplus",
-				Required:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "The human-friendly name for the sink",
-				Computed:            true,
-			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "A detailed text describes the sink",
 				Computed:            true,
@@ -67,6 +69,9 @@ func (d *sinkDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 			},
 		},
 	}
+
+	// lookup keys
+	maps.Copy(resp.Schema.Attributes, lookupAttributes("sink"))
 }
 
 func (d *sinkDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -89,6 +94,19 @@ func (d *sinkDataSource) Configure(ctx context.Context, req datasource.Configure
 	d.client = client
 }
 
+// ValidateConfig makes sure exactly one lookup key is set.
+func (d *sinkDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data *sinkDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateLookup("sink", data.ID, data.Name, data.NameRegex)...)
+}
+
 func (d *sinkDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data *sinkDataSourceModel
 
@@ -99,6 +117,22 @@ func (d *sinkDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
+	if data.ID.IsNull() {
+		l, err := d.client.ListSinks()
+		if err != nil {
+			resp.Diagnostics.AddError("Error Listing Sinks", fmt.Sprintf("Unable to list sinks, got error: %s", err))
+			return
+		}
+
+		found, diags := findByName("sink", l, func(s timeplus.Sink) string { return s.Name }, data.Name, data.NameRegex)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.ID = types.StringValue(found.ID)
+	}
+
 	s, err := d.client.GetSink(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -7,7 +7,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
-	"reflect"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	myplanmodifier "github.com/timeplus-io/terraform-provider-timeplus/internal/planmodifier"
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/sqlnorm"
 	"github.com/timeplus-io/terraform-provider-timeplus/internal/timeplus"
 	myvalidator "github.com/timeplus-io/terraform-provider-timeplus/internal/validator"
 )
@@ -25,6 +28,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &sinkResource{}
 var _ resource.ResourceWithImportState = &sinkResource{}
+var _ resource.ResourceWithModifyPlan = &sinkResource{}
+var _ resource.ResourceWithValidateConfig = &sinkResource{}
 
 func NewSinkResource() resource.Resource {
 	return &sinkResource{}
@@ -32,7 +37,8 @@ func NewSinkResource() resource.Resource {
 
 // sinkResource defines the resource implementation.
 type sinkResource struct {
-	client *timeplus.Client
+	client   *timeplus.Client
+	provider *providerData
 }
 
 // sinkResourceModel describes the sink resource data model.
@@ -47,6 +53,115 @@ type sinkResourceModel struct {
 
 	// Additional properties (in JSON) that required to write the data to the sink (e.g. broker url). Please refer to the sinks documentation
 	Properties types.String `tfsdk:"properties"`
+
+	// the alternative to `properties`, see propertiesMapAttribute
+	PropertiesMap types.Map `tfsdk:"properties_map"`
+
+	// Properties (in JSON) with secrets replaced by salted hashes, so that changes of non-secret properties are shown in plans
+	RedactedProperties types.String `tfsdk:"redacted_properties"`
+
+	// runtime status, see pipelineStatusAttributes
+	Paused          types.Bool   `tfsdk:"paused"`
+	FailOnUnhealthy types.Bool   `tfsdk:"fail_on_unhealthy"`
+	Status          types.String `tfsdk:"status"`
+	LastError       types.String `tfsdk:"last_error"`
+	Records         types.Int64  `tfsdk:"records"`
+	Bytes           types.Int64  `tfsdk:"bytes"`
+	FailedRecords   types.Int64  `tfsdk:"failed_records"`
+
+	// typed blocks of the common sink types, they can be used instead of `properties`, see sinkTypes
+	Kafka      types.Object `tfsdk:"kafka"`
+	Redpanda   types.Object `tfsdk:"redpanda"`
+	HTTP       types.Object `tfsdk:"http"`
+	Slack      types.Object `tfsdk:"slack"`
+	S3         types.Object `tfsdk:"s3"`
+	ClickHouse types.Object `tfsdk:"clickhouse"`
+	Timeplus   types.Object `tfsdk:"timeplus"`
+}
+
+// typedBlocks returns the typed blocks by the sink types
+func (m *sinkResourceModel) typedBlocks() map[string]*types.Object {
+	return map[string]*types.Object{
+		"kafka":      &m.Kafka,
+		"redpanda":   &m.Redpanda,
+		"http":       &m.HTTP,
+		"slack":      &m.Slack,
+		"s3":         &m.S3,
+		"clickhouse": &m.ClickHouse,
+		"timeplus":   &m.Timeplus,
+	}
+}
+
+// typedBlock returns the sink type and the typed block which is set, the type is empty when no typed block is set.
+func (m *sinkResourceModel) typedBlock() (string, types.Object) {
+	for typ, obj := range m.typedBlocks() {
+		if !obj.IsNull() {
+			return typ, *obj
+		}
+	}
+	return "", types.Object{}
+}
+
+// properties returns the properties sent to the API, either from the typed block or the `properties` JSON
+func (m *sinkResourceModel) properties() map[string]any {
+	if typ, obj := m.typedBlock(); typ != "" {
+		return sinkTypes[typ].toProperties(obj)
+	}
+
+	if !m.PropertiesMap.IsNull() {
+		return propertiesFromMap(m.PropertiesMap)
+	}
+
+	props := make(map[string]any)
+	// data.Properties uses the JsonObject validator, so it's guaranteed that it's a valid JSON object
+	if m.Properties.ValueString() != "" {
+		_ = json.Unmarshal([]byte(m.Properties.ValueString()), &props)
+	}
+	return props
+}
+
+// propertiesKnown reports whether the properties are fully known, i.e. properties() returns the final properties
+func (m *sinkResourceModel) propertiesKnown() bool {
+	if typ, obj := m.typedBlock(); typ != "" {
+		if obj.IsUnknown() {
+			return false
+		}
+		for _, v := range obj.Attributes() {
+			if v.IsUnknown() {
+				return false
+			}
+		}
+		return true
+	}
+	return !m.Properties.IsUnknown() && propertiesMapKnown(m.PropertiesMap)
+}
+
+// statusFields returns the fields of the runtime status
+func (m *sinkResourceModel) statusFields() pipelineStatusFields {
+	return pipelineStatusFields{
+		Paused:          &m.Paused,
+		FailOnUnhealthy: &m.FailOnUnhealthy,
+		Status:          &m.Status,
+		LastError:       &m.LastError,
+		Records:         &m.Records,
+		Bytes:           &m.Bytes,
+		FailedRecords:   &m.FailedRecords,
+	}
+}
+
+// redactProperties sets RedactedProperties from the properties, salts of hashes in `prior` are reused
+func (m *sinkResourceModel) redactProperties(prior types.String) error {
+	typ, _ := m.typedBlock()
+	if typ == "" {
+		typ = m.Type.ValueString()
+	}
+
+	redacted, err := redactPropertiesJSON(m.properties(), prior.ValueString(), "sink/"+m.Name.ValueString(), secretKeyMatcher(sinkTypes[typ]))
+	if err != nil {
+		return err
+	}
+	m.RedactedProperties = types.StringValue(redacted)
+	return nil
 }
 
 func (r *sinkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -75,27 +190,51 @@ func (r *sinkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional:            true,
 			},
 			"query": schema.StringAttribute{
-				MarkdownDescription: "The query the sink uses to generate data",
+				MarkdownDescription: "The query the sink uses to generate data. Formatting changes (whitespaces, comments, keyword case and identifier quoting) are ignored.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					myplanmodifier.SemanticSQL(),
+				},
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "The type of the sink, refer to the Timeplus document for supported sink types",
-				Required:            true,
+				MarkdownDescription: "The type of the sink, refer to the Timeplus document for supported sink types. It's required when `properties` is used, and it's set automatically when a typed block (e.g. `kafka`) is used.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			// since Terraform does not have built-in support for map[string]any with the framework library, we use JSON as a simple solution
 			"properties": schema.StringAttribute{
-				MarkdownDescription: "A JSON object defines the configurations for the specific sink type. The properites could contain sensitive information like password, secret, etc.",
+				MarkdownDescription: "A JSON object defines the configurations for the specific sink type. The properites could contain sensitive information like password, secret, etc. It's required unless `properties_map` or a typed block (e.g. `kafka`) is used.",
 				Sensitive:           true,
-				Required:            true,
+				Optional:            true,
 				Validators: []validator.String{
 					myvalidator.JsonObject(),
 				},
 			},
+			// `properties_map` is a new attribute and `properties` keeps its type, the states written before it's added are
+			// read with a null `properties_map`, thus no schema version bump or state upgrade is needed
+			"properties_map": propertiesMapAttribute("sink"),
+			"redacted_properties": schema.StringAttribute{
+				MarkdownDescription: "The properties in JSON with the secrets (e.g. password, token) replaced by salted hashes, so that changes of the non-secret properties are visible in plans. A hash only changes when the secret changes. Secrets returned by Timeplus as redaction markers (e.g. `******`) are ignored.",
+				Computed:            true,
+			},
 		},
+		Blocks: sinkTypeBlocks(),
+	}
+
+	// runtime status
+	maps.Copy(resp.Schema.Attributes, pipelineStatusAttributes("sink"))
+}
+
+func sinkTypeBlocks() map[string]schema.Block {
+	blocks := make(map[string]schema.Block, len(sinkTypes))
+	for typ, props := range sinkTypes {
+		blocks[typ] = props.block(fmt.Sprintf("The configurations of the %s sink, it can be used instead of `type` and `properties`.", typ))
 	}
+	return blocks
 }
 
 func (r *sinkResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -104,18 +243,109 @@ func (r *sinkResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	client, ok := req.ProviderData.(*timeplus.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *timeplus.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.provider = data
+}
+
+// ModifyPlan validates the query with the server when `validate_sql_on_plan` is enabled, and shows the redacted properties in the plan.
+func (r *sinkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateQueryOnPlan(ctx, r.provider, req, path.Root("query"))...)
+
+	var data *sinkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the type is decided by the typed block
+	if typ, _ := data.typedBlock(); typ != "" && data.Type.ValueString() != typ {
+		data.Type = types.StringValue(typ)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("type"), typ)...)
+
+		// the RequiresReplace plan modifier only sees the type from the state
+		if !req.State.Raw.IsNull() {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("type"))
+		}
+	}
+
+	// the salts of new secrets are derived from the name
+	if data.Type.IsUnknown() || data.Name.IsUnknown() || !data.propertiesKnown() {
+		return
+	}
+
+	var prior types.String
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("redacted_properties"), &prior)...)
+	}
+
+	if err := data.redactProperties(prior); err != nil {
+		resp.Diagnostics.AddError("Bad Sink Properties", fmt.Sprintf("Unable to redact sink properties, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("redacted_properties"), data.RedactedProperties)...)
+}
+
+// ValidateConfig makes sure either `properties` or exactly one typed block is set.
+func (r *sinkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *sinkResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var typed []string
+	for typ, obj := range data.typedBlocks() {
+		if !obj.IsNull() {
+			typed = append(typed, typ)
+		}
+	}
+	slices.Sort(typed)
+
+	switch len(typed) {
+	case 0:
+		if data.Type.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("type"), "Missing Sink Type", "`type` is required when no typed block (e.g. `kafka`) is used.")
+		}
+		if data.Properties.IsNull() && data.PropertiesMap.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("properties"), "Missing Sink Properties", "Either `properties` or `properties_map` is required when no typed block (e.g. `kafka`) is used.")
+		}
+		if !data.Properties.IsNull() && !data.PropertiesMap.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("properties_map"), "Conflicting Sink Properties", "`properties` and `properties_map` can't be used together.")
+		}
+	case 1:
+		typ := typed[0]
+		if !data.Properties.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("properties"), "Conflicting Sink Properties", fmt.Sprintf("`properties` can't be used together with the `%s` block.", typ))
+		}
+		if !data.PropertiesMap.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("properties_map"), "Conflicting Sink Properties", fmt.Sprintf("`properties_map` can't be used together with the `%s` block.", typ))
+		}
+		if !data.Type.IsNull() && !data.Type.IsUnknown() && data.Type.ValueString() != typ {
+			resp.Diagnostics.AddAttributeError(path.Root("type"), "Conflicting Sink Type", fmt.Sprintf("`type` is %q, but the `%s` block is used.", data.Type.ValueString(), typ))
+		}
+		resp.Diagnostics.Append(sinkTypes[typ].validate(*data.typedBlocks()[typ], path.Root(typ))...)
+	default:
+		resp.Diagnostics.AddError("Conflicting Sink Blocks", fmt.Sprintf("Only one typed block can be used, got: %s.", strings.Join(typed, ", ")))
+	}
 }
 
 func (r *sinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -128,18 +358,20 @@ func (r *sinkResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	props := make(map[string]any)
-	// data.Properties uses the JsonObject validator, so it's guaranteed that it's a valid JSON object
-	if data.Properties.ValueString() != "" {
-		_ = json.Unmarshal([]byte(data.Properties.ValueString()), &props)
-	}
-
 	s := timeplus.Sink{
 		Name:        data.Name.ValueString(),
 		Description: data.Description.ValueString(),
 		Query:       data.Query.ValueString(),
 		Type:        data.Type.ValueString(),
-		Properties:  props,
+		Properties:  data.properties(),
+	}
+
+	// it's redacted before the sink is created, so that a failure does not leave the created sink out of the state
+	if data.RedactedProperties.IsUnknown() {
+		if err := data.redactProperties(types.StringNull()); err != nil {
+			resp.Diagnostics.AddError("Bad Sink Properties", fmt.Sprintf("Unable to redact sink properties, got error: %s", err))
+			return
+		}
 	}
 
 	if err := r.client.CreateSink(&s); err != nil {
@@ -150,6 +382,15 @@ func (r *sinkResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// set Computed fields
 	data.ID = types.StringValue(s.ID)
 
+	if data.Paused.ValueBool() {
+		// the sink is created already, it's still saved into the state, so that Terraform marks it as tainted
+		if err := r.client.StopSink(s.ID); err != nil {
+			resp.Diagnostics.AddError("Error Stopping Sink", fmt.Sprintf("Unable to stop sink %q, got error: %s", s.Name, err))
+		}
+	}
+
+	resp.Diagnostics.Append(data.statusFields().refreshStatus(ctx, "sink", s.Name, r.status(s.ID))...)
+
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
 	tflog.Trace(ctx, "created a timeplus_sink resource")
@@ -158,30 +399,6 @@ func (r *sinkResource) Create(ctx context.Context, req resource.CreateRequest, r
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func deepCopyMap(ctx context.Context, dst, src map[string]any) {
-	for k, v := range src {
-		dv, ok := dst[k]
-		if !ok || dv == nil {
-			dst[k] = v
-			return
-		}
-
-		dm, ok := dv.(map[string]any)
-		if !ok {
-			dst[k] = v
-			return
-		}
-
-		sm, ok := v.(map[string]any)
-		if !ok {
-			dst[k] = v
-			return
-		}
-
-		deepCopyMap(ctx, dm, sm)
-	}
-}
-
 func (r *sinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data *sinkResourceModel
 
@@ -193,6 +410,11 @@ func (r *sinkResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	s, err := r.client.GetSink(data.ID.ValueString())
+	if timeplus.IsNotFound(err) {
+		// the sink is deleted outside of Terraform, e.g. by `cascade_delete` of the object it depends on
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Sink",
@@ -203,29 +425,47 @@ func (r *sinkResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	// required fields
 	data.Name = types.StringValue(s.Name)
-	data.Query = types.StringValue(s.Query)
 	data.Type = types.StringValue(s.Type)
 
-	// We can't compare the JSON directly since order is not guaranteed, need a bit more work to detect if properties are acutally changed
-	props := make(map[string]any)
-	if data.Properties.ValueString() != "" {
-		_ = json.Unmarshal([]byte(data.Properties.ValueString()), &props)
+	// the server may format the query differently, only update it when it's actually changed
+	if !sqlnorm.Equal(data.Query.ValueString(), s.Query) {
+		data.Query = types.StringValue(s.Query)
 	}
 
-	clone := maps.Clone(props)
-
-	// API does not return sensitive fields, thus we can't simply use s.Properties to replace data.Properties
-	deepCopyMap(ctx, props, s.Properties)
-
-	if !reflect.DeepEqual(clone, props) {
-		propsBytes, err := json.Marshal(s.Properties)
-		if err != nil {
-			resp.Diagnostics.AddError("Bad Sink Properties", fmt.Sprintf("Unable to encode sink properties into JSON, got error: %s", err))
-			return
+	isSecret := secretKeyMatcher(sinkTypes[s.Type])
+	typ, obj := data.typedBlock()
+	if typ == "" && data.Properties.IsNull() && data.PropertiesMap.IsNull() {
+		// it's imported, the typed block of the sink type is filled when there is one, otherwise `properties` is filled
+		if ps, ok := sinkTypes[s.Type]; ok {
+			typ, obj = s.Type, types.ObjectNull(ps.attrTypes())
+		}
+	}
+	if typ != "" {
+		block, diags := sinkTypes[typ].fromProperties(s.Properties, obj)
+		resp.Diagnostics.Append(diags...)
+		*data.typedBlocks()[typ] = block
+	} else if !data.PropertiesMap.IsNull() {
+		data.PropertiesMap = mergePropertiesMap(data.PropertiesMap, s.Properties, isSecret)
+	} else {
+		// API does not return secrets, thus we can't simply use s.Properties to replace data.Properties
+		props, changed := mergeProperties(ctx, data.Properties.ValueString(), s.Properties, isSecret)
+		if changed {
+			propsBytes, err := json.Marshal(props)
+			if err != nil {
+				resp.Diagnostics.AddError("Bad Sink Properties", fmt.Sprintf("Unable to encode sink properties into JSON, got error: %s", err))
+				return
+			}
+			data.Properties = types.StringValue(string(propsBytes))
 		}
-		data.Properties = types.StringValue(string(propsBytes))
 	}
 
+	if err := data.redactProperties(data.RedactedProperties); err != nil {
+		resp.Diagnostics.AddError("Bad Sink Properties", fmt.Sprintf("Unable to redact sink properties, got error: %s", err))
+		return
+	}
+
+	data.statusFields().readStatus(pipelineStatus{Status: s.Status, LastError: s.LastError, Stats: s.Stats})
+
 	// optional fields
 	if !(data.Description.IsNull() && s.Description == "") {
 		data.Description = types.StringValue(s.Description)
@@ -241,23 +481,21 @@ func (r *sinkResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	// Read Terraform prior state data into the model, it's used to decide whether the sink needs to be started
+	var state *sinkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	props := make(map[string]any)
-	// data.Properties uses the JsonObject validator, so it's guaranteed that it's a valid JSON object
-	if data.Properties.ValueString() != "" {
-		_ = json.Unmarshal([]byte(data.Properties.ValueString()), &props)
-	}
-
 	s := timeplus.Sink{
 		ID:          data.ID.ValueString(),
 		Name:        data.Name.ValueString(),
 		Description: data.Description.ValueString(),
 		Query:       data.Query.ValueString(),
 		Type:        data.Type.ValueString(),
-		Properties:  props,
+		Properties:  data.properties(),
 	}
 
 	if err := r.client.UpdateSink(&s); err != nil {
@@ -265,10 +503,40 @@ func (r *sinkResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	if data.RedactedProperties.IsUnknown() {
+		if err := data.redactProperties(state.RedactedProperties); err != nil {
+			resp.Diagnostics.AddError("Bad Sink Properties", fmt.Sprintf("Unable to redact sink properties, got error: %s", err))
+			return
+		}
+	}
+
+	// updating the sink restarts it, it needs to be stopped again
+	if data.Paused.ValueBool() {
+		if err := r.client.StopSink(s.ID); err != nil {
+			resp.Diagnostics.AddError("Error Stopping Sink", fmt.Sprintf("Unable to stop sink %q, got error: %s", s.Name, err))
+			return
+		}
+	} else if state.Paused.ValueBool() {
+		if err := r.client.StartSink(s.ID); err != nil {
+			resp.Diagnostics.AddError("Error Starting Sink", fmt.Sprintf("Unable to start sink %q, got error: %s", s.Name, err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(data.statusFields().refreshStatus(ctx, "sink", s.Name, r.status(s.ID))...)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// status returns the function fetches the runtime status of the sink
+func (r *sinkResource) status(id string) func() (pipelineStatus, error) {
+	return func() (pipelineStatus, error) {
+		s, err := r.client.GetSink(id)
+		return pipelineStatus{Status: s.Status, LastError: s.LastError, Stats: s.Stats}, err
+	}
+}
+
 func (r *sinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data *sinkResourceModel
 
@@ -285,6 +553,17 @@ func (r *sinkResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 }
 
+// ImportState accepts either the ID, or the name in the form of `name:<name>`. The rest of the state is filled by Read.
 func (r *sinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id, diags := resolveImportID("sink", req.ID, r.client.ListSinks,
+		func(s timeplus.Sink) string { return s.Name },
+		func(s timeplus.Sink) string { return s.ID },
+	)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSinkResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: `
+resource "timeplus_stream" "test" {
+  name = "test_sink_resource_stream"
+  column {
+    name = "city"
+    type = "string"
+  }
+}
+
+resource "timeplus_sink" "test" {
+  name = "test_sink_resource"
+  query = "select city from ${timeplus_stream.test.name}"
+  http {
+    url = "http://localhost:6789"
+    http_method = "POST"
+    content_type = "application/json"
+  }
+}
+        `,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("timeplus_sink.test", "name", "test_sink_resource"),
+					resource.TestCheckResourceAttr("timeplus_sink.test", "type", "http"),
+					resource.TestCheckResourceAttr("timeplus_sink.test", "http.url", "http://localhost:6789"),
+				),
+			},
+			// ImportState testing, the typed block is filled from the properties
+			{
+				ResourceName:      "timeplus_sink.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the runtime status changes between refreshes
+				ImportStateVerifyIgnore: []string{"records", "bytes", "failed_records", "status", "last_error"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
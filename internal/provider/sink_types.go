@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	myvalidator "github.com/timeplus-io/terraform-provider-timeplus/internal/validator"
+)
+
+// kafkaSinkProperties are shared by the kafka and redpanda sinks
+var kafkaSinkProperties = typedProperties{
+	{name: "brokers", key: "brokers", description: "The comma-separated list of the Kafka brokers, e.g. `broker1:9092,broker2:9092`", required: true},
+	{name: "topic", key: "topic", description: "The topic the data is written to", required: true},
+	{name: "data_format", key: "data_format", description: "The format of the messages, e.g. `JSONEachRow`, `CSV`, `Avro` or `ProtobufSingle`"},
+	{name: "sasl", key: "sasl", description: "The SASL mechanism. Options: none, plain, scram-sha-256, scram-sha-512", validators: []validator.String{
+		myvalidator.OneOf("none", "plain", "scram-sha-256", "scram-sha-512"),
+	}},
+	{name: "username", key: "username", description: "The username for SASL authentication"},
+	{name: "password", key: "password", description: "The password for SASL authentication", sensitive: true},
+	{name: "tls", key: "tls", kind: propertyBool, description: "Whether to connect the brokers with TLS"},
+	{name: "skip_ssl_cert_check", key: "skip_ssl_cert_check", kind: propertyBool, description: "Whether to skip verifying the server certificate"},
+	{name: "one_message_per_row", key: "one_message_per_row", kind: propertyBool, description: "Whether to write each row as a separate message"},
+}
+
+// sinkTypes are the sink types which can be configured with typed blocks instead of the `properties` JSON
+var sinkTypes = map[string]typedProperties{
+	"kafka":    kafkaSinkProperties,
+	"redpanda": kafkaSinkProperties,
+	"http": {
+		{name: "url", key: "url", description: "The URL of the HTTP endpoint (i.e. webhook) the data is sent to", required: true, validators: []validator.String{
+			myvalidator.URL(),
+		}},
+		{name: "http_method", key: "http_method", description: "The HTTP method. Options: POST, PUT, PATCH", validators: []validator.String{
+			myvalidator.OneOf("POST", "PUT", "PATCH"),
+		}},
+		{name: "content_type", key: "content_type", description: "The content type of the requests, e.g. `application/json`"},
+		{name: "payload_field", key: "payload_field", description: "The template of the request body. Fields of the row can be referred in the template. When it's not set, the rows are sent as JSON"},
+		{name: "http_header", key: "http_header", kind: propertyStringMap, description: "Additional HTTP headers sent with the requests, e.g. `Authorization`", sensitive: true},
+	},
+	"slack": {
+		{name: "webhook_url", key: "url", description: "The URL of the Slack incoming webhook", required: true, sensitive: true, validators: []validator.String{
+			myvalidator.URL(),
+		}},
+		{name: "message_template", key: "message_template", description: "The template of the message. Fields of the row can be referred in the template"},
+	},
+	"s3": {
+		{name: "bucket", key: "bucket", description: "The S3 bucket name", required: true},
+		{name: "region", key: "region", description: "The region of the bucket, e.g. `us-west-2`", required: true},
+		{name: "endpoint", key: "endpoint", description: "The endpoint of an S3 compatible storage, e.g. `http://minio:9000`", validators: []validator.String{
+			myvalidator.URL(),
+		}},
+		{name: "path_prefix", key: "path_prefix", description: "The prefix of the objects written to the bucket"},
+		{name: "data_format", key: "data_format", description: "The format of the objects, e.g. `JSONEachRow`, `CSV` or `Parquet`"},
+		{name: "compression", key: "compression", description: "The compression of the objects. Options: none, gzip, zstd", validators: []validator.String{
+			myvalidator.OneOf("none", "gzip", "zstd"),
+		}},
+		{name: "access_key_id", key: "access_key_id", description: "The access key ID"},
+		{name: "secret_access_key", key: "secret_access_key", description: "The secret access key", sensitive: true},
+	},
+	"clickhouse": {
+		{name: "address", key: "address", description: "The address of the ClickHouse server, e.g. `clickhouse:9000`", required: true},
+		{name: "database", key: "database", description: "The database name"},
+		{name: "table", key: "table", description: "The table the data is written to", required: true},
+		{name: "username", key: "username", description: "The username"},
+		{name: "password", key: "password", description: "The password", sensitive: true},
+		{name: "secure", key: "secure", kind: propertyBool, description: "Whether to connect the server with TLS"},
+	},
+	"timeplus": {
+		{name: "url", key: "url", description: "The URL of the target Timeplus, e.g. `https://us-west-2.timeplus.cloud`", required: true, validators: []validator.String{
+			myvalidator.URL(),
+		}},
+		{name: "workspace", key: "workspace", description: "The workspace ID of the target Timeplus"},
+		{name: "stream", key: "stream", description: "The stream the data is written to", required: true},
+		{name: "api_key", key: "api_key", description: "The API key of the target Timeplus", sensitive: true},
+	},
+}
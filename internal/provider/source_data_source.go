@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -16,6 +17,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &sourceDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &sourceDataSource{}
 
 func NewSourceDataSource() datasource.DataSource {
 	return &sourceDataSource{}
@@ -27,7 +29,15 @@ type sourceDataSource struct {
 }
 
 // sourceDataSourceModel describes the data source data model.
-type sourceDataSourceModel sourceResourceModel
+type sourceDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	NameRegex   types.String `tfsdk:"name_regex"`
+	Description types.String `tfsdk:"description"`
+	Stream      types.String `tfsdk:"stream"`
+	Type        types.String `tfsdk:"type"`
+	Properties  types.String `tfsdk:"properties"`
+}
 
 func (d *sourceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_source"
@@ -39,14 +49,6 @@ func (d *sourceDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 		MarkdownDescription: "Timeplus sources run queries in background and send query results to the target system continuously.",
 
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "The source immutable ID, generated by Timeplus",
-				Required:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "The human-friendly name for the source",
-				Computed:            true,
-			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "A detailed text describes the source",
 				Computed:            true,
@@ -67,6 +69,9 @@ func (d *sourceDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 			},
 		},
 	}
+
+	// lookup keys
+	maps.Copy(resp.Schema.Attributes, lookupAttributes("source"))
 }
 
 func (d *sourceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -89,6 +94,19 @@ func (d *sourceDataSource) Configure(ctx context.Context, req datasource.Configu
 	d.client = client
 }
 
+// ValidateConfig makes sure exactly one lookup key is set.
+func (d *sourceDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data *sourceDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateLookup("source", data.ID, data.Name, data.NameRegex)...)
+}
+
 func (d *sourceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data *sourceDataSourceModel
 
@@ -99,6 +117,22 @@ func (d *sourceDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	if data.ID.IsNull() {
+		l, err := d.client.ListSources()
+		if err != nil {
+			resp.Diagnostics.AddError("Error Listing Sources", fmt.Sprintf("Unable to list sources, got error: %s", err))
+			return
+		}
+
+		found, diags := findByName("source", l, func(s timeplus.Source) string { return s.Name }, data.Name, data.NameRegex)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.ID = types.StringValue(found.ID)
+	}
+
 	s, err := d.client.GetSource(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
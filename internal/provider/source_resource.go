@@ -7,8 +7,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
-	"reflect"
+	"slices"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -25,6 +27,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &sourceResource{}
 var _ resource.ResourceWithImportState = &sourceResource{}
+var _ resource.ResourceWithModifyPlan = &sourceResource{}
+var _ resource.ResourceWithValidateConfig = &sourceResource{}
 
 func NewSourceResource() resource.Resource {
 	return &sourceResource{}
@@ -47,6 +51,108 @@ type sourceResourceModel struct {
 
 	// Additional properties (in JSON) that required to write the data to the source (e.g. broker url). Please refer to the sources documentation
 	Properties types.String `tfsdk:"properties"`
+
+	// the alternative to `properties`, see propertiesMapAttribute
+	PropertiesMap types.Map `tfsdk:"properties_map"`
+
+	// typed blocks of the common source types, they can be used instead of `properties`, see sourceTypes
+	Kafka    types.Object `tfsdk:"kafka"`
+	Redpanda types.Object `tfsdk:"redpanda"`
+
+	// reads sample messages before creating the source, see PreviewSource
+	PreviewOnCreate types.Bool `tfsdk:"preview_on_create"`
+
+	// Properties (in JSON) with secrets replaced by salted hashes, so that changes of non-secret properties are shown in plans
+	RedactedProperties types.String `tfsdk:"redacted_properties"`
+
+	// runtime status, see pipelineStatusAttributes
+	Paused          types.Bool   `tfsdk:"paused"`
+	FailOnUnhealthy types.Bool   `tfsdk:"fail_on_unhealthy"`
+	Status          types.String `tfsdk:"status"`
+	LastError       types.String `tfsdk:"last_error"`
+	Records         types.Int64  `tfsdk:"records"`
+	Bytes           types.Int64  `tfsdk:"bytes"`
+	FailedRecords   types.Int64  `tfsdk:"failed_records"`
+}
+
+// typedBlocks returns the typed blocks by the source types
+func (m *sourceResourceModel) typedBlocks() map[string]*types.Object {
+	return map[string]*types.Object{
+		"kafka":    &m.Kafka,
+		"redpanda": &m.Redpanda,
+	}
+}
+
+// typedBlock returns the source type and the typed block which is set, the type is empty when no typed block is set.
+func (m *sourceResourceModel) typedBlock() (string, types.Object) {
+	for typ, obj := range m.typedBlocks() {
+		if !obj.IsNull() {
+			return typ, *obj
+		}
+	}
+	return "", types.Object{}
+}
+
+// properties returns the properties sent to the API, either from the typed block, the `properties_map` or the `properties` JSON
+func (m *sourceResourceModel) properties() map[string]any {
+	if typ, obj := m.typedBlock(); typ != "" {
+		return sourceTypes[typ].toProperties(obj)
+	}
+
+	if !m.PropertiesMap.IsNull() {
+		return propertiesFromMap(m.PropertiesMap)
+	}
+
+	props := make(map[string]any)
+	// data.Properties uses the JsonObject validator, so it's guaranteed that it's a valid JSON object
+	if m.Properties.ValueString() != "" {
+		_ = json.Unmarshal([]byte(m.Properties.ValueString()), &props)
+	}
+	return props
+}
+
+// propertiesKnown reports whether the properties are fully known, i.e. properties() returns the final properties
+func (m *sourceResourceModel) propertiesKnown() bool {
+	if typ, obj := m.typedBlock(); typ != "" {
+		if obj.IsUnknown() {
+			return false
+		}
+		for _, v := range obj.Attributes() {
+			if v.IsUnknown() {
+				return false
+			}
+		}
+		return true
+	}
+	return !m.Properties.IsUnknown() && propertiesMapKnown(m.PropertiesMap)
+}
+
+// statusFields returns the fields of the runtime status
+func (m *sourceResourceModel) statusFields() pipelineStatusFields {
+	return pipelineStatusFields{
+		Paused:          &m.Paused,
+		FailOnUnhealthy: &m.FailOnUnhealthy,
+		Status:          &m.Status,
+		LastError:       &m.LastError,
+		Records:         &m.Records,
+		Bytes:           &m.Bytes,
+		FailedRecords:   &m.FailedRecords,
+	}
+}
+
+// redactProperties sets RedactedProperties from the properties, salts of hashes in `prior` are reused
+func (m *sourceResourceModel) redactProperties(prior types.String) error {
+	typ, _ := m.typedBlock()
+	if typ == "" {
+		typ = m.Type.ValueString()
+	}
+
+	redacted, err := redactPropertiesJSON(m.properties(), prior.ValueString(), "source/"+m.Name.ValueString(), secretKeyMatcher(sourceTypes[typ]))
+	if err != nil {
+		return err
+	}
+	m.RedactedProperties = types.StringValue(redacted)
+	return nil
 }
 
 func (r *sourceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -79,23 +185,48 @@ func (r *sourceResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Required:            true,
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "The type of the source, refer to the Timeplus document for supported source types",
-				Required:            true,
+				MarkdownDescription: "The type of the source, refer to the Timeplus document for supported source types. It's required when `properties` or `properties_map` is used, and it's set automatically when a typed block (e.g. `kafka`) is used.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			// since Terraform does not have built-in support for map[string]any with the framework library, we use JSON as a simple solution
 			"properties": schema.StringAttribute{
-				MarkdownDescription: "A JSON object defines the configurations for the specific source type. The properites could contain sensitive information like password, secret, etc.",
-				Required:            true,
+				MarkdownDescription: "A JSON object defines the configurations for the specific source type. The properites could contain sensitive information like password, secret, etc. It's required unless `properties_map` or a typed block (e.g. `kafka`) is used.",
+				Optional:            true,
 				Sensitive:           true,
 				Validators: []validator.String{
 					myvalidator.JsonObject(),
 				},
 			},
+			// `properties_map` is a new attribute and `properties` keeps its type, the states written before it's added are
+			// read with a null `properties_map`, thus no schema version bump or state upgrade is needed
+			"properties_map": propertiesMapAttribute("source"),
+			"preview_on_create": schema.BoolAttribute{
+				MarkdownDescription: "When it's `true`, sample messages are read with the configurations before the source is created, and the creation fails if none of them can be decoded, e.g. because of a wrong broker address or data format.",
+				Optional:            true,
+			},
+			"redacted_properties": schema.StringAttribute{
+				MarkdownDescription: "The properties in JSON with the secrets (e.g. password, token) replaced by salted hashes, so that changes of the non-secret properties are visible in plans. A hash only changes when the secret changes. Secrets returned by Timeplus as redaction markers (e.g. `******`) are ignored.",
+				Computed:            true,
+			},
 		},
+		Blocks: sourceTypeBlocks(),
+	}
+
+	// runtime status
+	maps.Copy(resp.Schema.Attributes, pipelineStatusAttributes("source"))
+}
+
+func sourceTypeBlocks() map[string]schema.Block {
+	blocks := make(map[string]schema.Block, len(sourceTypes))
+	for typ, props := range sourceTypes {
+		blocks[typ] = props.block(fmt.Sprintf("The configurations of the %s source, it can be used instead of `type` and `properties`.", typ))
 	}
+	return blocks
 }
 
 func (r *sourceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -104,18 +235,106 @@ func (r *sourceResource) Configure(_ context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	client, ok := req.ProviderData.(*timeplus.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *timeplus.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+}
+
+// ModifyPlan sets the type from the typed block, and shows the redacted properties in the plan.
+func (r *sourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data *sourceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the type is decided by the typed block
+	if typ, _ := data.typedBlock(); typ != "" && data.Type.ValueString() != typ {
+		data.Type = types.StringValue(typ)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("type"), typ)...)
+
+		// the RequiresReplace plan modifier only sees the type from the state
+		if !req.State.Raw.IsNull() {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("type"))
+		}
+	}
+
+	// the salts of new secrets are derived from the name
+	if data.Type.IsUnknown() || data.Name.IsUnknown() || !data.propertiesKnown() {
+		return
+	}
+
+	var prior types.String
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("redacted_properties"), &prior)...)
+	}
+
+	if err := data.redactProperties(prior); err != nil {
+		resp.Diagnostics.AddError("Bad Source Properties", fmt.Sprintf("Unable to redact source properties, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("redacted_properties"), data.RedactedProperties)...)
+}
+
+// ValidateConfig makes sure either `properties`, `properties_map` or exactly one typed block is set.
+func (r *sourceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *sourceResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var typed []string
+	for typ, obj := range data.typedBlocks() {
+		if !obj.IsNull() {
+			typed = append(typed, typ)
+		}
+	}
+	slices.Sort(typed)
+
+	switch len(typed) {
+	case 0:
+		if data.Type.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("type"), "Missing Source Type", "`type` is required when no typed block (e.g. `kafka`) is used.")
+		}
+		if data.Properties.IsNull() && data.PropertiesMap.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("properties"), "Missing Source Properties", "Either `properties` or `properties_map` is required when no typed block (e.g. `kafka`) is used.")
+		}
+		if !data.Properties.IsNull() && !data.PropertiesMap.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("properties_map"), "Conflicting Source Properties", "`properties` and `properties_map` can't be used together.")
+		}
+	case 1:
+		typ := typed[0]
+		if !data.Properties.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("properties"), "Conflicting Source Properties", fmt.Sprintf("`properties` can't be used together with the `%s` block.", typ))
+		}
+		if !data.PropertiesMap.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("properties_map"), "Conflicting Source Properties", fmt.Sprintf("`properties_map` can't be used together with the `%s` block.", typ))
+		}
+		if !data.Type.IsNull() && !data.Type.IsUnknown() && data.Type.ValueString() != typ {
+			resp.Diagnostics.AddAttributeError(path.Root("type"), "Conflicting Source Type", fmt.Sprintf("`type` is %q, but the `%s` block is used.", data.Type.ValueString(), typ))
+		}
+		resp.Diagnostics.Append(sourceTypes[typ].validate(*data.typedBlocks()[typ], path.Root(typ))...)
+	default:
+		resp.Diagnostics.AddError("Conflicting Source Blocks", fmt.Sprintf("Only one typed block can be used, got: %s.", strings.Join(typed, ", ")))
+	}
 }
 
 func (r *sourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -128,18 +347,28 @@ func (r *sourceResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	props := make(map[string]any)
-	// data.Properties uses the JsonObject validator, so it's guaranteed that it's a valid JSON object
-	if data.Properties.ValueString() != "" {
-		_ = json.Unmarshal([]byte(data.Properties.ValueString()), &props)
-	}
-
 	s := timeplus.Source{
 		Name:        data.Name.ValueString(),
 		Description: data.Description.ValueString(),
 		Stream:      data.Stream.ValueString(),
 		Type:        data.Type.ValueString(),
-		Properties:  props,
+		Properties:  data.properties(),
+	}
+
+	// it's redacted before the source is created, so that a failure does not leave the created source out of the state
+	if data.RedactedProperties.IsUnknown() {
+		if err := data.redactProperties(types.StringNull()); err != nil {
+			resp.Diagnostics.AddError("Bad Source Properties", fmt.Sprintf("Unable to redact source properties, got error: %s", err))
+			return
+		}
+	}
+
+	if data.PreviewOnCreate.ValueBool() {
+		resp.Diagnostics.Append(r.preview(&s)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	if err := r.client.CreateSource(&s); err != nil {
@@ -150,6 +379,15 @@ func (r *sourceResource) Create(ctx context.Context, req resource.CreateRequest,
 	// set Computed fields
 	data.ID = types.StringValue(s.ID)
 
+	if data.Paused.ValueBool() {
+		// the source is created already, it's still saved into the state, so that Terraform marks it as tainted
+		if err := r.client.StopSource(s.ID); err != nil {
+			resp.Diagnostics.AddError("Error Stopping Source", fmt.Sprintf("Unable to stop source %q, got error: %s", s.Name, err))
+		}
+	}
+
+	resp.Diagnostics.Append(data.statusFields().refreshStatus(ctx, "source", s.Name, r.status(s.ID))...)
+
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
 	tflog.Trace(ctx, "created a timeplus_source resource")
@@ -169,6 +407,11 @@ func (r *sourceResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	s, err := r.client.GetSource(data.ID.ValueString())
+	if timeplus.IsNotFound(err) {
+		// the source is deleted outside of Terraform, e.g. by `cascade_delete` of the object it depends on
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Source",
@@ -182,26 +425,40 @@ func (r *sourceResource) Read(ctx context.Context, req resource.ReadRequest, res
 	data.Stream = types.StringValue(s.Stream)
 	data.Type = types.StringValue(s.Type)
 
-	// We can't compare the JSON directly since order is not guaranteed, need a bit more work to detect if properties are acutally changed
-	props := make(map[string]any)
-	if data.Properties.ValueString() != "" {
-		_ = json.Unmarshal([]byte(data.Properties.ValueString()), &props)
+	isSecret := secretKeyMatcher(sourceTypes[s.Type])
+	typ, obj := data.typedBlock()
+	if typ == "" && data.Properties.IsNull() && data.PropertiesMap.IsNull() {
+		// it's imported, the typed block of the source type is filled when there is one, otherwise `properties` is filled
+		if ps, ok := sourceTypes[s.Type]; ok {
+			typ, obj = s.Type, types.ObjectNull(ps.attrTypes())
+		}
 	}
-
-	clone := maps.Clone(props)
-
-	// API does not return sensitive fields, thus we can't simply use s.Properties to replace data.Properties
-	deepCopyMap(ctx, props, s.Properties)
-
-	if !reflect.DeepEqual(clone, props) {
-		propsBytes, err := json.Marshal(s.Properties)
-		if err != nil {
-			resp.Diagnostics.AddError("Bad Source Properties", fmt.Sprintf("Unable to encode source properties into JSON, got error: %s", err))
-			return
+	if typ != "" {
+		block, diags := sourceTypes[typ].fromProperties(s.Properties, obj)
+		resp.Diagnostics.Append(diags...)
+		*data.typedBlocks()[typ] = block
+	} else if !data.PropertiesMap.IsNull() {
+		data.PropertiesMap = mergePropertiesMap(data.PropertiesMap, s.Properties, isSecret)
+	} else {
+		// API does not return secrets, thus we can't simply use s.Properties to replace data.Properties
+		props, changed := mergeProperties(ctx, data.Properties.ValueString(), s.Properties, isSecret)
+		if changed {
+			propsBytes, err := json.Marshal(props)
+			if err != nil {
+				resp.Diagnostics.AddError("Bad Source Properties", fmt.Sprintf("Unable to encode source properties into JSON, got error: %s", err))
+				return
+			}
+			data.Properties = types.StringValue(string(propsBytes))
 		}
-		data.Properties = types.StringValue(string(propsBytes))
 	}
 
+	if err := data.redactProperties(data.RedactedProperties); err != nil {
+		resp.Diagnostics.AddError("Bad Source Properties", fmt.Sprintf("Unable to redact source properties, got error: %s", err))
+		return
+	}
+
+	data.statusFields().readStatus(pipelineStatus{Status: s.Status, LastError: s.LastError, Stats: s.Stats})
+
 	// optional fields
 	if !(data.Description.IsNull() && s.Description == "") {
 		data.Description = types.StringValue(s.Description)
@@ -217,23 +474,21 @@ func (r *sourceResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	// Read Terraform prior state data into the model, it's used to decide whether the source needs to be started
+	var state *sourceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	props := make(map[string]any)
-	// data.Properties uses the JsonObject validator, so it's guaranteed that it's a valid JSON object
-	if data.Properties.ValueString() != "" {
-		_ = json.Unmarshal([]byte(data.Properties.ValueString()), &props)
-	}
-
 	s := timeplus.Source{
 		ID:          data.ID.ValueString(),
 		Name:        data.Name.ValueString(),
 		Description: data.Description.ValueString(),
 		Stream:      data.Stream.ValueString(),
 		Type:        data.Type.ValueString(),
-		Properties:  props,
+		Properties:  data.properties(),
 	}
 
 	if err := r.client.UpdateSource(&s); err != nil {
@@ -241,10 +496,61 @@ func (r *sourceResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	if data.RedactedProperties.IsUnknown() {
+		if err := data.redactProperties(state.RedactedProperties); err != nil {
+			resp.Diagnostics.AddError("Bad Source Properties", fmt.Sprintf("Unable to redact source properties, got error: %s", err))
+			return
+		}
+	}
+
+	// updating the source restarts it, it needs to be stopped again
+	if data.Paused.ValueBool() {
+		if err := r.client.StopSource(s.ID); err != nil {
+			resp.Diagnostics.AddError("Error Stopping Source", fmt.Sprintf("Unable to stop source %q, got error: %s", s.Name, err))
+			return
+		}
+	} else if state.Paused.ValueBool() {
+		if err := r.client.StartSource(s.ID); err != nil {
+			resp.Diagnostics.AddError("Error Starting Source", fmt.Sprintf("Unable to start source %q, got error: %s", s.Name, err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(data.statusFields().refreshStatus(ctx, "source", s.Name, r.status(s.ID))...)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// preview reads sample messages with the configurations of the source, and fails if none of them can be decoded.
+func (r *sourceResource) preview(s *timeplus.Source) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	p, err := r.client.PreviewSource(s)
+	if err != nil {
+		diags.AddError("Error Previewing Source", fmt.Sprintf("Unable to preview source %q, got error: %s", s.Name, err))
+		return diags
+	}
+
+	if len(p.Samples) == 0 {
+		detail := fmt.Sprintf("No sample messages of source %q can be decoded, please check the connectivity and the data format.", s.Name)
+		if len(p.Errors) > 0 {
+			detail += fmt.Sprintf(" Got errors: %s", strings.Join(p.Errors, "; "))
+		}
+		diags.AddError("Source Preview Failed", detail)
+	}
+
+	return diags
+}
+
+// status returns the function fetches the runtime status of the source
+func (r *sourceResource) status(id string) func() (pipelineStatus, error) {
+	return func() (pipelineStatus, error) {
+		s, err := r.client.GetSource(id)
+		return pipelineStatus{Status: s.Status, LastError: s.LastError, Stats: s.Stats}, err
+	}
+}
+
 func (r *sourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data *sourceResourceModel
 
@@ -261,6 +567,17 @@ func (r *sourceResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+// ImportState accepts either the ID, or the name in the form of `name:<name>`. The rest of the state is filled by Read.
 func (r *sourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id, diags := resolveImportID("source", req.ID, r.client.ListSources,
+		func(s timeplus.Source) string { return s.Name },
+		func(s timeplus.Source) string { return s.ID },
+	)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	myvalidator "github.com/timeplus-io/terraform-provider-timeplus/internal/validator"
+)
+
+// kafkaSourceProperties are shared by the kafka and redpanda sources
+var kafkaSourceProperties = typedProperties{
+	{name: "brokers", key: "brokers", description: "The comma-separated list of the Kafka brokers, e.g. `broker1:9092,broker2:9092`", required: true},
+	{name: "topic", key: "topic", description: "The topic the data is read from", required: true},
+	{name: "group", key: "group", description: "The consumer group ID. When it's not set, a group ID is generated by Timeplus"},
+	{name: "offset", key: "offset", description: "Where to start reading when the consumer group does not have a committed offset. Options: earliest, latest", validators: []validator.String{
+		myvalidator.OneOf("earliest", "latest"),
+	}},
+	{name: "data_type", key: "data_type", description: "The format of the messages, e.g. `json`, `text` or `avro`"},
+	{name: "sasl", key: "sasl", description: "The SASL mechanism. Options: none, plain, scram-sha-256, scram-sha-512", validators: []validator.String{
+		myvalidator.OneOf("none", "plain", "scram-sha-256", "scram-sha-512"),
+	}},
+	{name: "username", key: "username", description: "The username for SASL authentication"},
+	{name: "password", key: "password", description: "The password for SASL authentication", sensitive: true},
+	{name: "tls_disable", key: "tls.disable", kind: propertyBool, description: "Whether to connect the brokers without TLS"},
+	{name: "tls_skip_verify_server", key: "tls.skip_verify_server", kind: propertyBool, description: "Whether to skip verifying the server certificate"},
+	{name: "tls_ca_cert", key: "tls.ca_cert", description: "The PEM encoded CA certificate used to verify the brokers"},
+	{name: "tls_client_cert", key: "tls.client_cert", description: "The PEM encoded client certificate for mutual TLS"},
+	{name: "tls_client_key", key: "tls.client_key", description: "The PEM encoded private key of the client certificate", sensitive: true},
+}
+
+// sourceTypes are the source types which can be configured with typed blocks instead of the `properties` JSON
+var sourceTypes = map[string]typedProperties{
+	"kafka":    kafkaSourceProperties,
+	"redpanda": kafkaSourceProperties,
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/timeplus"
+)
+
+// validateQueryOnPlan analyzes the planned query with the server when the `validate_sql_on_plan` provider setting is
+// enabled, and reports invalid queries as errors of the attribute. Queries which are not known yet, are not changed
+// since the last apply, or refer to objects which don't exist yet, are skipped.
+func validateQueryOnPlan(ctx context.Context, data *providerData, req resource.ModifyPlanRequest, attr path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data == nil || !data.validateSQLOnPlan {
+		return diags
+	}
+
+	var query types.String
+	diags.Append(req.Plan.GetAttribute(ctx, attr, &query)...)
+	if diags.HasError() || query.IsNull() || query.IsUnknown() {
+		return diags
+	}
+
+	if !req.State.Raw.IsNull() {
+		var stateQuery types.String
+		diags.Append(req.State.GetAttribute(ctx, attr, &stateQuery)...)
+		if diags.HasError() || query.Equal(stateQuery) {
+			return diags
+		}
+	}
+
+	client := data.client
+
+	_, err := client.AnalyzeSQL(query.ValueString())
+	if err == nil {
+		return diags
+	}
+
+	var sqlErr *timeplus.SQLError
+	if !errors.As(err, &sqlErr) {
+		diags.AddAttributeWarning(attr, "Unable to Validate SQL", fmt.Sprintf("Failed to analyze the query, got error: %s", err))
+		return diags
+	}
+
+	if sqlErr.IsUnknownObject() {
+		tflog.Debug(ctx, "skip validating the query which refers to unknown objects", map[string]any{"error": sqlErr.Message})
+		return diags
+	}
+
+	detail := sqlErr.Message
+	if sqlErr.Line > 0 {
+		detail = fmt.Sprintf("Line %d, column %d: %s", sqlErr.Line, sqlErr.Column, sqlErr.Message)
+	}
+	diags.AddAttributeError(attr, "Invalid SQL", detail)
+
+	return diags
+}
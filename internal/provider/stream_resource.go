@@ -5,16 +5,19 @@ package provider
 import (
 	"context"
 	"fmt"
-	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	myplanmodifier "github.com/timeplus-io/terraform-provider-timeplus/internal/planmodifier"
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/sqlnorm"
 	"github.com/timeplus-io/terraform-provider-timeplus/internal/timeplus"
 )
 
@@ -28,7 +31,8 @@ func NewStreamResource() resource.Resource {
 
 // streamResource defines the resource implementation.
 type streamResource struct {
-	client *timeplus.Client
+	client   *timeplus.Client
+	provider *providerData
 }
 
 type columnModel struct {
@@ -49,6 +53,7 @@ type streamResourceModel struct {
 	RetentionMS    types.Int64   `tfsdk:"retention_ms"`
 	HistoryTTL     types.String  `tfsdk:"history_ttl"`
 	Mode           types.String  `tfsdk:"mode"`
+	CascadeDelete  types.Bool    `tfsdk:"cascade_delete"`
 }
 
 func (r *streamResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -69,6 +74,12 @@ func (r *streamResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				MarkdownDescription: "A detailed text describes the stream",
 				Optional:            true,
 			},
+			"cascade_delete": schema.BoolAttribute{
+				MarkdownDescription: "When it's `true`, the objects (e.g. views, materialized views and sinks) depend on the stream are deleted before the stream is deleted or recreated. Their definitions are read before they are deleted, and they are recreated after the stream is recreated in the same apply, sinks and sources can get new IDs. They are not recreated when the stream is only destroyed. Otherwise, deleting the stream fails when any object depends on it. It must be applied before the stream is deleted to take effect. Default: false",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"mode": schema.StringAttribute{
 				MarkdownDescription: "The stream mode. Options: append, changelog, changelog_kv, versioned_kv. Default: \"append\"",
 				Optional:            true,
@@ -86,43 +97,51 @@ func (r *streamResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			"history_ttl": schema.StringAttribute{
 				MarkdownDescription: "A SQL expression defines the maximum age of data that are persisted in the historical store",
 				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					myplanmodifier.SemanticSQL(),
+				},
 			},
 		},
 		Blocks: map[string]schema.Block{
 			"column": schema.ListNestedBlock{
 				MarkdownDescription: "Define the columns of the stream",
-				NestedObject: schema.NestedBlockObject{
-					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							MarkdownDescription: "The column name",
-							Required:            true,
-						},
-						"type": schema.StringAttribute{
-							MarkdownDescription: "The type name of the column",
-							Required:            true,
-						},
-						"default": schema.StringAttribute{
-							MarkdownDescription: "The default value for the column",
-							Optional:            true,
-							Computed:            true,
-							Default:             stringdefault.StaticString(""),
-						},
-						"codec": schema.StringAttribute{
-							MarkdownDescription: "The codec for value encoding",
-							Optional:            true,
-							Computed:            true,
-							Default:             stringdefault.StaticString(""),
-						},
-						"use_as_event_time": schema.BoolAttribute{
-							MarkdownDescription: "If set to `true`, this column will be used as the event time column (by default ingest time will be used as event time). Only one column can be marked as the event time column in a stream.",
-							Optional:            true,
-						},
-						"primary_key": schema.BoolAttribute{
-							MarkdownDescription: "If set to `true`, this column will be used as the primary key, or part of the combined primary key if multiple columns are marked as primary keys.",
-							Optional:            true,
-						},
-					},
-				},
+				NestedObject:        columnBlockObject(),
+			},
+		},
+	}
+}
+
+// columnBlockObject returns the schema of the `column` block, which is shared by all the stream-like resources
+func columnBlockObject() schema.NestedBlockObject {
+	return schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The column name",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type name of the column",
+				Required:            true,
+			},
+			"default": schema.StringAttribute{
+				MarkdownDescription: "The default value for the column",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"codec": schema.StringAttribute{
+				MarkdownDescription: "The codec for value encoding",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"use_as_event_time": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, this column will be used as the event time column (by default ingest time will be used as event time). Only one column can be marked as the event time column in a stream.",
+				Optional:            true,
+			},
+			"primary_key": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, this column will be used as the primary key, or part of the combined primary key if multiple columns are marked as primary keys.",
+				Optional:            true,
 			},
 		},
 	}
@@ -134,18 +153,19 @@ func (r *streamResource) Configure(_ context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	client, ok := req.ProviderData.(*timeplus.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *timeplus.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.provider = data
 }
 
 func (r *streamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -225,12 +245,13 @@ func (r *streamResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Documentation: https://terraform.io/plugin/log
 	tflog.Trace(ctx, "created a timeplus_stream resource")
 
+	// the dependents deleted by `cascade_delete` when the old one was deleted during the replacement
+	resp.Diagnostics.Append(recreateDependents(ctx, r.provider, data.Name.ValueString())...)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-var spaces = regexp.MustCompile(`\s+`)
-
 func (r *streamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data *streamResourceModel
 
@@ -242,6 +263,11 @@ func (r *streamResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	s, err := r.client.GetStream(data.Name.ValueString())
+	if timeplus.IsNotFound(err) {
+		// the stream is deleted outside of Terraform, e.g. by `cascade_delete` of the object it depends on
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Stream",
@@ -323,8 +349,8 @@ func (r *streamResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	if !(data.HistoryTTL.IsNull() && s.HistoricalTTLExpression == "") {
-		// assume TTL expressions are space insignificant
-		if spaces.ReplaceAllString(data.HistoryTTL.ValueString(), "") != spaces.ReplaceAllString(s.HistoricalTTLExpression, "") {
+		// the server may format the expression differently, only update it when it's actually changed
+		if !sqlnorm.Equal(data.HistoryTTL.ValueString(), s.HistoricalTTLExpression) {
 			data.HistoryTTL = types.StringValue(s.HistoricalTTLExpression)
 		}
 	}
@@ -333,6 +359,11 @@ func (r *streamResource) Read(ctx context.Context, req resource.ReadRequest, res
 		data.Mode = types.StringValue(s.Mode)
 	}
 
+	// cascade_delete only exists in Terraform, it's null after importing
+	if data.CascadeDelete.IsNull() {
+		data.CascadeDelete = types.BoolValue(false)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -420,6 +451,12 @@ func (r *streamResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(deleteDependents(ctx, r.provider, "stream", data.Name.ValueString(), data.CascadeDelete.ValueBool())...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.client.DeleteStream(&timeplus.Stream{Name: data.Name.ValueString()})
 	if err != nil {
 		resp.Diagnostics.AddError("Error Deleting Stream", fmt.Sprintf("Unable to delete stream %q, got error: %s", data.Name.ValueString(), err))
@@ -0,0 +1,232 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type propertyKind int
+
+const (
+	propertyString propertyKind = iota
+	propertyBool
+	propertyInt64
+	propertyStringMap
+)
+
+// typedProperty describes an attribute of a typed block (e.g. the `kafka` block of sinks), and the key in `properties`
+// it's sent to the server as. Keys of nested properties are separated by dots, e.g. `tls.disable`.
+type typedProperty struct {
+	name        string
+	key         string
+	kind        propertyKind
+	description string
+	required    bool
+	sensitive   bool
+	validators  []validator.String
+}
+
+// typedProperties are the attributes of a typed block, they are converted from/to the `properties` of the API.
+type typedProperties []typedProperty
+
+func (ps typedProperties) attrTypes() map[string]attr.Type {
+	attrTypes := make(map[string]attr.Type, len(ps))
+	for _, p := range ps {
+		switch p.kind {
+		case propertyBool:
+			attrTypes[p.name] = types.BoolType
+		case propertyInt64:
+			attrTypes[p.name] = types.Int64Type
+		case propertyStringMap:
+			attrTypes[p.name] = types.MapType{ElemType: types.StringType}
+		default:
+			attrTypes[p.name] = types.StringType
+		}
+	}
+	return attrTypes
+}
+
+// block returns the schema of the typed block. Required attributes are checked by validate, since the block itself is optional.
+func (ps typedProperties) block(description string) schema.SingleNestedBlock {
+	attrs := make(map[string]schema.Attribute, len(ps))
+	for _, p := range ps {
+		desc := p.description
+		if p.required {
+			desc += ". Required"
+		}
+
+		switch p.kind {
+		case propertyBool:
+			attrs[p.name] = schema.BoolAttribute{MarkdownDescription: desc, Optional: true, Sensitive: p.sensitive}
+		case propertyInt64:
+			attrs[p.name] = schema.Int64Attribute{MarkdownDescription: desc, Optional: true, Sensitive: p.sensitive}
+		case propertyStringMap:
+			attrs[p.name] = schema.MapAttribute{MarkdownDescription: desc, Optional: true, Sensitive: p.sensitive, ElementType: types.StringType}
+		default:
+			attrs[p.name] = schema.StringAttribute{MarkdownDescription: desc, Optional: true, Sensitive: p.sensitive, Validators: p.validators}
+		}
+	}
+
+	return schema.SingleNestedBlock{
+		MarkdownDescription: description,
+		Attributes:          attrs,
+	}
+}
+
+// validate checks the required attributes are set in the block at `p`.
+func (ps typedProperties) validate(obj types.Object, p path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if obj.IsNull() || obj.IsUnknown() {
+		return diags
+	}
+
+	attrs := obj.Attributes()
+	for _, prop := range ps {
+		if prop.required && attrs[prop.name].IsNull() {
+			diags.AddAttributeError(p.AtName(prop.name), "Missing Required Attribute", fmt.Sprintf("`%s` is required in the `%s` block.", prop.name, p))
+		}
+	}
+	return diags
+}
+
+// toProperties converts the block to the properties sent to the API, unset attributes are omitted.
+func (ps typedProperties) toProperties(obj types.Object) map[string]any {
+	props := map[string]any{}
+
+	attrs := obj.Attributes()
+	for _, p := range ps {
+		v, ok := attrs[p.name]
+		if !ok || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		switch v := v.(type) {
+		case types.Bool:
+			setProperty(props, p.key, v.ValueBool())
+		case types.Int64:
+			setProperty(props, p.key, v.ValueInt64())
+		case types.Map:
+			m := make(map[string]string, len(v.Elements()))
+			for k, e := range v.Elements() {
+				if s, ok := e.(types.String); ok {
+					m[k] = s.ValueString()
+				}
+			}
+			setProperty(props, p.key, m)
+		case types.String:
+			setProperty(props, p.key, v.ValueString())
+		}
+	}
+	return props
+}
+
+// fromProperties converts the properties returned by the API to the block. The API does not return sensitive values,
+// they are always kept from `prior`. Properties which are not returned keep their prior values as well.
+func (ps typedProperties) fromProperties(props map[string]any, prior types.Object) (types.Object, diag.Diagnostics) {
+	priorAttrs := map[string]attr.Value{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		priorAttrs = prior.Attributes()
+	}
+
+	attrTypes := ps.attrTypes()
+	attrs := make(map[string]attr.Value, len(ps))
+	for _, p := range ps {
+		priorValue, ok := priorAttrs[p.name]
+		if !ok {
+			priorValue = nullValue(attrTypes[p.name])
+		}
+
+		v, ok := getProperty(props, p.key)
+		if p.sensitive || !ok || v == nil {
+			attrs[p.name] = priorValue
+			continue
+		}
+
+		attrs[p.name] = propertyValue(p.kind, v, priorValue)
+	}
+
+	return types.ObjectValue(attrTypes, attrs)
+}
+
+// propertyValue converts a value decoded from JSON to the Terraform value. Empty values are treated as unset, if they are unset in `prior`.
+func propertyValue(kind propertyKind, v any, prior attr.Value) attr.Value {
+	switch kind {
+	case propertyBool:
+		if b, ok := v.(bool); ok && !(prior.IsNull() && !b) {
+			return types.BoolValue(b)
+		}
+	case propertyInt64:
+		if n, ok := v.(float64); ok && !(prior.IsNull() && n == 0) {
+			return types.Int64Value(int64(n))
+		}
+	case propertyStringMap:
+		if m, ok := v.(map[string]any); ok && !(prior.IsNull() && len(m) == 0) {
+			elems := make(map[string]attr.Value, len(m))
+			for k, e := range m {
+				elems[k] = types.StringValue(fmt.Sprint(e))
+			}
+			return types.MapValueMust(types.StringType, elems)
+		}
+	default:
+		if s := fmt.Sprint(v); !(prior.IsNull() && s == "") {
+			return types.StringValue(s)
+		}
+	}
+	return prior
+}
+
+func nullValue(t attr.Type) attr.Value {
+	switch t := t.(type) {
+	case types.MapType:
+		return types.MapNull(t.ElemType)
+	}
+
+	switch t {
+	case types.BoolType:
+		return types.BoolNull()
+	case types.Int64Type:
+		return types.Int64Null()
+	}
+	return types.StringNull()
+}
+
+// setProperty sets the value of a (nested) property, the objects on the path are created when they don't exist.
+func setProperty(props map[string]any, key string, v any) {
+	parent, leaf, nested := strings.Cut(key, ".")
+	if !nested {
+		props[key] = v
+		return
+	}
+
+	m, ok := props[parent].(map[string]any)
+	if !ok {
+		m = map[string]any{}
+		props[parent] = m
+	}
+	setProperty(m, leaf, v)
+}
+
+// getProperty returns the value of a (nested) property
+func getProperty(props map[string]any, key string) (any, bool) {
+	parent, leaf, nested := strings.Cut(key, ".")
+	if !nested {
+		v, ok := props[key]
+		return v, ok
+	}
+
+	m, ok := props[parent].(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	return getProperty(m, leaf)
+}
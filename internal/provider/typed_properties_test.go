@@ -0,0 +1,214 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var testProperties = typedProperties{
+	{name: "brokers", key: "brokers"},
+	{name: "password", key: "password", sensitive: true},
+	{name: "tls", key: "tls", kind: propertyBool},
+	{name: "batch_size", key: "batch_size", kind: propertyInt64},
+	{name: "http_header", key: "http_header", kind: propertyStringMap},
+}
+
+// testObject returns a block of testProperties, attributes not in `attrs` are null
+func testObject(attrs map[string]attr.Value) types.Object {
+	attrTypes := testProperties.attrTypes()
+	values := make(map[string]attr.Value, len(attrTypes))
+	for name, t := range attrTypes {
+		values[name] = nullValue(t)
+	}
+	for name, v := range attrs {
+		values[name] = v
+	}
+	return types.ObjectValueMust(attrTypes, values)
+}
+
+func TestTypedPropertiesToProperties(t *testing.T) {
+	obj := testObject(map[string]attr.Value{
+		"brokers":     types.StringValue("a:9092"),
+		"password":    types.StringValue("s3cret"),
+		"tls":         types.BoolValue(false),
+		"batch_size":  types.Int64Value(100),
+		"http_header": types.MapValueMust(types.StringType, map[string]attr.Value{"Authorization": types.StringValue("Bearer t")}),
+	})
+
+	want := map[string]any{
+		"brokers":     "a:9092",
+		"password":    "s3cret",
+		"tls":         false,
+		"batch_size":  float64(100),
+		"http_header": map[string]any{"Authorization": "Bearer t"},
+	}
+	if got := testProperties.toProperties(obj); !jsonEqual(got, want) {
+		t.Errorf("toProperties() = %s, want %s", jsonString(got), jsonString(want))
+	}
+
+	// unset and unknown attributes are omitted
+	obj = testObject(map[string]attr.Value{
+		"brokers":    types.StringValue("a:9092"),
+		"batch_size": types.Int64Unknown(),
+	})
+	want = map[string]any{"brokers": "a:9092"}
+	if got := testProperties.toProperties(obj); !jsonEqual(got, want) {
+		t.Errorf("toProperties() = %s, want %s", jsonString(got), jsonString(want))
+	}
+}
+
+func TestTypedPropertiesFromProperties(t *testing.T) {
+	cases := []struct {
+		name  string
+		props map[string]any
+		prior types.Object
+		want  types.Object
+	}{
+		{
+			name: "conversion",
+			props: map[string]any{
+				"brokers":     "b:9092",
+				"tls":         true,
+				"batch_size":  float64(100),
+				"http_header": map[string]any{"X-Retry": float64(3)},
+			},
+			prior: types.ObjectNull(testProperties.attrTypes()),
+			want: testObject(map[string]attr.Value{
+				"brokers":     types.StringValue("b:9092"),
+				"tls":         types.BoolValue(true),
+				"batch_size":  types.Int64Value(100),
+				"http_header": types.MapValueMust(types.StringType, map[string]attr.Value{"X-Retry": types.StringValue("3")}),
+			}),
+		},
+		{
+			name:  "sensitive values are kept",
+			props: map[string]any{"brokers": "a:9092", "password": "******"},
+			prior: testObject(map[string]attr.Value{
+				"brokers":  types.StringValue("a:9092"),
+				"password": types.StringValue("s3cret"),
+			}),
+			want: testObject(map[string]attr.Value{
+				"brokers":  types.StringValue("a:9092"),
+				"password": types.StringValue("s3cret"),
+			}),
+		},
+		{
+			name:  "empty values keep unset attributes unset",
+			props: map[string]any{"brokers": "", "tls": false, "batch_size": float64(0)},
+			prior: testObject(nil),
+			want:  testObject(nil),
+		},
+		{
+			name:  "empty values of set attributes",
+			props: map[string]any{"brokers": "", "tls": false, "batch_size": float64(0)},
+			prior: testObject(map[string]attr.Value{
+				"brokers":    types.StringValue("a:9092"),
+				"tls":        types.BoolValue(true),
+				"batch_size": types.Int64Value(100),
+			}),
+			want: testObject(map[string]attr.Value{
+				"brokers":    types.StringValue(""),
+				"tls":        types.BoolValue(false),
+				"batch_size": types.Int64Value(0),
+			}),
+		},
+		{
+			name:  "missing values keep prior values",
+			props: map[string]any{},
+			prior: testObject(map[string]attr.Value{"tls": types.BoolValue(true)}),
+			want:  testObject(map[string]attr.Value{"tls": types.BoolValue(true)}),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, diags := testProperties.fromProperties(c.props, c.prior)
+			if diags.HasError() {
+				t.Fatalf("fromProperties() diags = %v", diags)
+			}
+			if !got.Equal(c.want) {
+				t.Errorf("fromProperties() = %s, want %s", got, c.want)
+			}
+		})
+	}
+}
+
+func TestNestedProperties(t *testing.T) {
+	props := map[string]any{"brokers": "a:9092"}
+	setProperty(props, "tls.disable", true)
+	setProperty(props, "tls.ca_cert", "pem")
+	setProperty(props, "group", "g1")
+
+	want := map[string]any{
+		"brokers": "a:9092",
+		"group":   "g1",
+		"tls":     map[string]any{"disable": true, "ca_cert": "pem"},
+	}
+	if !jsonEqual(props, want) {
+		t.Errorf("setProperty() = %s, want %s", jsonString(props), jsonString(want))
+	}
+
+	cases := []struct {
+		key    string
+		want   any
+		wantOK bool
+	}{
+		{key: "brokers", want: "a:9092", wantOK: true},
+		{key: "tls.disable", want: true, wantOK: true},
+		{key: "tls.ca_cert", want: "pem", wantOK: true},
+		{key: "tls.client_key"},
+		{key: "brokers.host"},
+		{key: "sasl.mechanism"},
+	}
+	for _, c := range cases {
+		got, ok := getProperty(props, c.key)
+		if ok != c.wantOK || got != c.want {
+			t.Errorf("getProperty(%q) = %v, %v, want %v, %v", c.key, got, ok, c.want, c.wantOK)
+		}
+	}
+
+	// a non-object value on the path is replaced
+	props = map[string]any{"tls": true}
+	setProperty(props, "tls.disable", true)
+	if got, _ := getProperty(props, "tls.disable"); got != true {
+		t.Errorf("getProperty(tls.disable) = %v, want true", got)
+	}
+}
+
+func TestKafkaSourcePropertiesRoundTrip(t *testing.T) {
+	ps := sourceTypes["kafka"]
+	attrTypes := ps.attrTypes()
+	values := make(map[string]attr.Value, len(attrTypes))
+	for name, t := range attrTypes {
+		values[name] = nullValue(t)
+	}
+	values["brokers"] = types.StringValue("a:9092")
+	values["topic"] = types.StringValue("cities")
+	values["tls_skip_verify_server"] = types.BoolValue(true)
+	values["tls_client_key"] = types.StringValue("key")
+	obj := types.ObjectValueMust(attrTypes, values)
+
+	props := ps.toProperties(obj)
+	want := map[string]any{
+		"brokers": "a:9092",
+		"topic":   "cities",
+		"tls":     map[string]any{"skip_verify_server": true, "client_key": "key"},
+	}
+	if !jsonEqual(props, want) {
+		t.Fatalf("toProperties() = %s, want %s", jsonString(props), jsonString(want))
+	}
+
+	// the server does not return the client key
+	props["tls"] = map[string]any{"skip_verify_server": true}
+	got, diags := ps.fromProperties(props, obj)
+	if diags.HasError() {
+		t.Fatalf("fromProperties() diags = %v", diags)
+	}
+	if !got.Equal(obj) {
+		t.Errorf("fromProperties() = %s, want %s", got, obj)
+	}
+}
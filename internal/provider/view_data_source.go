@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/timeplus-io/terraform-provider-timeplus/internal/timeplus"
@@ -30,6 +31,9 @@ type viewDataSourceModel struct {
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
 	Query       types.String `tfsdk:"query"`
+	Columns     types.List   `tfsdk:"columns"`
+
+	Parameters []viewParameterModel `tfsdk:"parameters"`
 }
 
 func (d *viewDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -54,6 +58,27 @@ func (d *viewDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 				MarkdownDescription: "The query SQL of the view",
 				Computed:            true,
 			},
+			"columns": columnInfoListDataSourceAttribute("The output columns of the view"),
+			"parameters": schema.ListNestedAttribute{
+				MarkdownDescription: "The parameters of the view, it's empty if the view is not parameterized",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The parameter name",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The parameter type",
+							Computed:            true,
+						},
+						"default": schema.StringAttribute{
+							MarkdownDescription: "The default value of the parameter",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -97,6 +122,17 @@ func (d *viewDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	data.Name = types.StringValue(v.Name)
 	data.Description = types.StringValue(v.Description)
 	data.Query = types.StringValue(v.Query)
+	data.Parameters = viewParameterModels(v.Parameters)
+
+	columns, err := d.client.DescribeView(v.Name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Describing View", fmt.Sprintf("Unable to describe view %q, got error: %s", v.Name, err))
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.Columns, diags = columnInfoListValue(columns)
+	resp.Diagnostics.Append(diags...)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
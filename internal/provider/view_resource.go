@@ -5,19 +5,30 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"slices"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	myplanmodifier "github.com/timeplus-io/terraform-provider-timeplus/internal/planmodifier"
+	"github.com/timeplus-io/terraform-provider-timeplus/internal/sqlnorm"
 	"github.com/timeplus-io/terraform-provider-timeplus/internal/timeplus"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &viewResource{}
 var _ resource.ResourceWithImportState = &viewResource{}
+var _ resource.ResourceWithModifyPlan = &viewResource{}
+var _ resource.ResourceWithValidateConfig = &viewResource{}
 
 func NewViewResource() resource.Resource {
 	return &viewResource{}
@@ -25,14 +36,91 @@ func NewViewResource() resource.Resource {
 
 // viewResource defines the resource implementation.
 type viewResource struct {
-	client *timeplus.Client
+	client   *timeplus.Client
+	provider *providerData
 }
 
 // viewResourceModel describes the stream resource data model.
 type viewResourceModel struct {
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Query       types.String `tfsdk:"query"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	Query         types.String `tfsdk:"query"`
+	CascadeDelete types.Bool   `tfsdk:"cascade_delete"`
+
+	// it's a list rather than []viewParameterModel, since the whole list may be unknown in ValidateConfig
+	Parameters types.List `tfsdk:"parameters"`
+
+	// read-only
+	Columns types.List `tfsdk:"columns"`
+}
+
+type viewParameterModel struct {
+	Name    types.String `tfsdk:"name"`
+	Type    types.String `tfsdk:"type"`
+	Default types.String `tfsdk:"default"`
+}
+
+var viewParameterAttrTypes = map[string]attr.Type{
+	"name":    types.StringType,
+	"type":    types.StringType,
+	"default": types.StringType,
+}
+
+// viewParametersFrom converts the parameters list to the parameters sent to the API
+func viewParametersFrom(ctx context.Context, l types.List) ([]timeplus.ViewParameter, diag.Diagnostics) {
+	var models []viewParameterModel
+	diags := l.ElementsAs(ctx, &models, false)
+	if len(models) == 0 {
+		return nil, diags
+	}
+
+	params := make([]timeplus.ViewParameter, 0, len(models))
+	for _, m := range models {
+		params = append(params, timeplus.ViewParameter{
+			Name:    m.Name.ValueString(),
+			Type:    m.Type.ValueString(),
+			Default: m.Default.ValueString(),
+		})
+	}
+	return params, diags
+}
+
+// viewParametersListValue converts the parameters returned by the API to the parameters list
+func viewParametersListValue(ctx context.Context, params []timeplus.ViewParameter) (types.List, diag.Diagnostics) {
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: viewParameterAttrTypes}, viewParameterModels(params))
+}
+
+func viewParameterModels(params []timeplus.ViewParameter) []viewParameterModel {
+	models := make([]viewParameterModel, 0, len(params))
+	for _, p := range params {
+		m := viewParameterModel{
+			Name:    types.StringValue(p.Name),
+			Type:    types.StringValue(p.Type),
+			Default: types.StringNull(),
+		}
+		if p.Default != "" {
+			m.Default = types.StringValue(p.Default)
+		}
+		models = append(models, m)
+	}
+	return models
+}
+
+// viewParameterPlaceholder matches `{{name:type}}` in the query of a parameterized view
+var viewParameterPlaceholder = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*:\s*(.+?)\s*\}\}`)
+
+// viewParameterPlaceholders returns the types of the parameters used in the query by their names. A parameter used
+// with different types is reported as an error.
+func viewParameterPlaceholders(query string) (map[string]string, error) {
+	placeholders := map[string]string{}
+	for _, match := range viewParameterPlaceholder.FindAllStringSubmatch(query, -1) {
+		name, typ := match[1], match[2]
+		if prev, ok := placeholders[name]; ok && !strings.EqualFold(prev, typ) {
+			return nil, fmt.Errorf("parameter %q is used as both %s and %s", name, prev, typ)
+		}
+		placeholders[name] = typ
+	}
+	return placeholders, nil
 }
 
 func (r *viewResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -53,9 +141,39 @@ func (r *viewResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				MarkdownDescription: "A detailed text describes the view",
 				Optional:            true,
 			},
+			"cascade_delete": schema.BoolAttribute{
+				MarkdownDescription: "When it's `true`, the objects (e.g. views, materialized views and sinks) depend on the view are deleted before the view is deleted or recreated. Their definitions are read before they are deleted, and they are recreated after the view is recreated in the same apply, sinks and sources can get new IDs. They are not recreated when the view is only destroyed. Otherwise, deleting the view fails when any object depends on it. It must be applied before the view is deleted to take effect. Default: false",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"query": schema.StringAttribute{
-				MarkdownDescription: "The query SQL of the view",
+				MarkdownDescription: "The query SQL of the view. Changing the SQL replaces the query in place, the objects depend on the view are kept. Formatting changes (whitespaces, comments, keyword case and identifier quoting) are ignored. Parameters are referred by `name:type` wrapped in double curly braces, see the example.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					myplanmodifier.SemanticSQL(),
+				},
+			},
+			"columns": columnInfoListAttribute("The output columns of the view"),
+			"parameters": schema.ListNestedAttribute{
+				MarkdownDescription: "The parameters of a parameterized view. Every parameter must be used in the query with the same type, and every placeholder in the query must be declared.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The parameter name",
+							Required:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The parameter type, e.g. `string`, `int32` or `datetime64(3)`",
+							Required:            true,
+						},
+						"default": schema.StringAttribute{
+							MarkdownDescription: "The default value of the parameter, it's used when the parameter is not provided when querying the view",
+							Optional:            true,
+						},
+					},
+				},
 			},
 		},
 	}
@@ -67,18 +185,135 @@ func (r *viewResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	client, ok := req.ProviderData.(*timeplus.Client)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *timeplus.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.provider = data
+}
+
+// ValidateConfig checks the parameters match the placeholders in the query.
+func (r *viewResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *viewResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Query.IsUnknown() {
+		return
+	}
+
+	placeholders, err := viewParameterPlaceholders(data.Query.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("query"), "Invalid Parameter Placeholder", err.Error())
+		return
+	}
+
+	// e.g. `parameters = var.params` during `terraform validate`
+	if data.Parameters.IsUnknown() {
+		return
+	}
+	for _, e := range data.Parameters.Elements() {
+		if e.IsUnknown() {
+			return
+		}
+	}
+
+	var params []viewParameterModel
+	resp.Diagnostics.Append(data.Parameters.ElementsAs(ctx, &params, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	declared := map[string]bool{}
+	for i, p := range params {
+		if p.Name.IsUnknown() || p.Type.IsUnknown() {
+			return
+		}
+
+		name := p.Name.ValueString()
+		if declared[name] {
+			resp.Diagnostics.AddAttributeError(path.Root("parameters").AtListIndex(i).AtName("name"), "Duplicated Parameter", fmt.Sprintf("Parameter %q is declared more than once.", name))
+			continue
+		}
+		declared[name] = true
+
+		typ, ok := placeholders[name]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(path.Root("parameters").AtListIndex(i), "Unused Parameter", fmt.Sprintf("Parameter %q is not used in the query, it should be referred as `{{%s:%s}}`.", name, name, p.Type.ValueString()))
+		} else if !strings.EqualFold(typ, p.Type.ValueString()) {
+			resp.Diagnostics.AddAttributeError(path.Root("parameters").AtListIndex(i).AtName("type"), "Mismatched Parameter Type", fmt.Sprintf("Parameter %q is declared as %s, but used as %s in the query.", name, p.Type.ValueString(), typ))
+		}
+	}
+
+	for name, typ := range placeholders {
+		if !declared[name] {
+			resp.Diagnostics.AddAttributeError(path.Root("query"), "Undeclared Parameter", fmt.Sprintf("The query refers to parameter `{{%s:%s}}`, but it's not declared in `parameters`.", name, typ))
+		}
+	}
+}
+
+// ModifyPlan validates the query with the server when `validate_sql_on_plan` is enabled, queries of parameterized
+// views are not validated.
+func (r *viewResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var query types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("query"), &query)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the analyzer does not understand the `{{name:type}}` placeholders of parameterized views
+	if placeholders, err := viewParameterPlaceholders(query.ValueString()); err == nil && len(placeholders) == 0 {
+		resp.Diagnostics.Append(validateQueryOnPlan(ctx, r.provider, req, path.Root("query"))...)
+	}
+
+	// the resource is being created
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var stateQuery types.String
+	var params, stateParams types.List
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("query"), &stateQuery)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("parameters"), &params)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("parameters"), &stateParams)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the output columns change with the query
+	if !query.Equal(stateQuery) || !params.Equal(stateParams) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("columns"), columnInfoListUnknown())...)
+	}
+}
+
+// readColumns fetches the output columns of the view.
+func (r *viewResource) readColumns(data *viewResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	columns, err := r.client.DescribeView(data.Name.ValueString())
+	if err != nil {
+		diags.AddError("Error Describing View", fmt.Sprintf("Unable to describe view %q, got error: %s", data.Name.ValueString(), err))
+		return diags
+	}
+
+	data.Columns, diags = columnInfoListValue(columns)
+	return diags
 }
 
 func (r *viewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -91,10 +326,18 @@ func (r *viewResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	params, diags := viewParametersFrom(ctx, data.Parameters)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	v := timeplus.View{
 		Name:        data.Name.ValueString(),
 		Description: data.Description.ValueString(),
 		Query:       data.Query.ValueString(),
+		Parameters:  params,
 	}
 	if err := r.client.CreateView(&v); err != nil {
 		resp.Diagnostics.AddError("Error Creating View", fmt.Sprintf("Unable to create view %q, got error: %s", v.Name, err))
@@ -105,6 +348,11 @@ func (r *viewResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Documentation: https://terraform.io/plugin/log
 	tflog.Trace(ctx, "created a timeplus_view resource")
 
+	// the dependents deleted by `cascade_delete` when the old one was deleted during the replacement
+	resp.Diagnostics.Append(recreateDependents(ctx, r.provider, data.Name.ValueString())...)
+
+	resp.Diagnostics.Append(r.readColumns(data)...)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -120,6 +368,11 @@ func (r *viewResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	v, err := r.client.GetView(data.Name.ValueString())
+	if timeplus.IsNotFound(err) {
+		// the view is deleted outside of Terraform, e.g. by `cascade_delete` of the object it depends on
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading View",
@@ -130,13 +383,30 @@ func (r *viewResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	// required fields
 	data.Name = types.StringValue(v.Name)
-	data.Query = types.StringValue(v.Query)
+
+	// the server may format the query differently, only update it when it's actually changed
+	if !sqlnorm.Equal(data.Query.ValueString(), v.Query) {
+		data.Query = types.StringValue(v.Query)
+	}
 
 	// optional fields
 	if !(data.Description.IsNull() && v.Description == "") {
 		data.Description = types.StringValue(v.Description)
 	}
 
+	if !(data.Parameters.IsNull() && len(v.Parameters) == 0) {
+		params, diags := viewParametersListValue(ctx, v.Parameters)
+		resp.Diagnostics.Append(diags...)
+		data.Parameters = params
+	}
+
+	// cascade_delete only exists in Terraform, it's null after importing
+	if data.CascadeDelete.IsNull() {
+		data.CascadeDelete = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(r.readColumns(data)...)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -151,16 +421,44 @@ func (r *viewResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	var state *viewResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := viewParametersFrom(ctx, data.Parameters)
+	resp.Diagnostics.Append(diags...)
+	stateParams, diags := viewParametersFrom(ctx, state.Parameters)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	v := timeplus.View{
 		Name:        data.Name.ValueString(),
 		Description: data.Description.ValueString(),
 		Query:       data.Query.ValueString(),
+		Parameters:  params,
 	}
-	if err := r.client.UpdateView(&v); err != nil {
+
+	// the update API ignores the query and parameters, replace the view instead so that the objects depend on it are kept
+	update := r.client.UpdateView
+	if !data.Query.Equal(state.Query) || !slices.Equal(v.Parameters, stateParams) {
+		update = r.client.ReplaceView
+	}
+
+	if err := update(&v); err != nil {
 		resp.Diagnostics.AddError("Error Updating View", fmt.Sprintf("Unable to update view %q, got error: %s", v.Name, err))
 		return
 	}
 
+	resp.Diagnostics.Append(r.readColumns(data)...)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -175,6 +473,12 @@ func (r *viewResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	resp.Diagnostics.Append(deleteDependents(ctx, r.provider, "view", data.Name.ValueString(), data.CascadeDelete.ValueBool())...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.client.DeleteView(&timeplus.View{Name: data.Name.ValueString()})
 	if err != nil {
 		resp.Diagnostics.AddError("Error Deleting View", fmt.Sprintf("Unable to delete view %q, got error: %s", data.Name.ValueString(), err))
@@ -0,0 +1,322 @@
+// SPDX-License-Identifier: MPL-2.0
+
+// Package sqlnorm normalizes SQL queries, so that queries which only differ in formatting can be treated as the same.
+//
+// The normalization is purely lexical: comments are removed, consecutive whitespaces are collapsed, keywords are
+// lower-cased, quotes around identifiers which don't need them are removed and trailing semicolons are dropped.
+// String literals and the case of identifiers are always kept as is, since they are significant. Words which are
+// keywords only in certain positions (e.g. `year` of `INTERVAL 1 YEAR`) are lower-cased only in these positions.
+package sqlnorm
+
+import (
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenQuotedIdentifier
+	tokenString
+	tokenNumber
+	tokenSymbol
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+}
+
+// Normalize returns the normalized form of the SQL query. Two queries are semantically the same if their normalized forms are equal.
+func Normalize(sql string) string {
+	tokens := tokenize(sql)
+
+	// trailing semicolons are insignificant
+	for len(tokens) > 0 && tokens[len(tokens)-1].kind == tokenSymbol && tokens[len(tokens)-1].value == ";" {
+		tokens = tokens[:len(tokens)-1]
+	}
+
+	parts := make([]string, 0, len(tokens))
+	for i, t := range tokens {
+		switch t.kind {
+		case tokenWord:
+			if isKeywordAt(tokens, i) {
+				parts = append(parts, strings.ToLower(t.value))
+			} else {
+				parts = append(parts, t.value)
+			}
+		case tokenQuotedIdentifier:
+			// `name` and "name" are the same as name, unless the quotes are required
+			name := t.value[1 : len(t.value)-1]
+			if isPlainIdentifier(name) && !isKeyword(name) {
+				parts = append(parts, name)
+			} else {
+				parts = append(parts, "`"+name+"`")
+			}
+		default:
+			parts = append(parts, t.value)
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// Equal reports whether the two SQL queries are semantically the same, i.e. they only differ in formatting.
+func Equal(a, b string) bool {
+	return Normalize(a) == Normalize(b)
+}
+
+// References reports whether the SQL query refers to the identifier `name`, either bare or quoted. Identifiers are
+// case-sensitive, string literals and comments are not searched.
+func References(sql, name string) bool {
+	for _, t := range tokenize(sql) {
+		switch t.kind {
+		case tokenWord:
+			if t.value == name {
+				return true
+			}
+		case tokenQuotedIdentifier:
+			if t.value[1:len(t.value)-1] == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// twoCharSymbols are the operators which consist of two characters, they must not be split into two tokens.
+var twoCharSymbols = []string{"!=", "<>", "<=", ">=", "==", "||", "::", "->"}
+
+func tokenize(sql string) []token {
+	var tokens []token
+
+	rs := []rune(sql)
+	for i := 0; i < len(rs); {
+		r := rs[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		// line comment
+		case r == '-' && i+1 < len(rs) && rs[i+1] == '-':
+			for i < len(rs) && rs[i] != '\n' {
+				i++
+			}
+
+		// block comment
+		case r == '/' && i+1 < len(rs) && rs[i+1] == '*':
+			i += 2
+			for i < len(rs) && !(rs[i] == '*' && i+1 < len(rs) && rs[i+1] == '/') {
+				i++
+			}
+			i += 2
+
+		case r == '\'':
+			end := scanQuoted(rs, i)
+			tokens = append(tokens, token{kind: tokenString, value: string(rs[i:end])})
+			i = end
+
+		case r == '`' || r == '"':
+			end := scanQuoted(rs, i)
+			// an unterminated quoted identifier is kept as is
+			kind := tokenQuotedIdentifier
+			if end-i < 2 || rs[end-1] != r {
+				kind = tokenSymbol
+			}
+			tokens = append(tokens, token{kind: kind, value: string(rs[i:end])})
+			i = end
+
+		case unicode.IsDigit(r):
+			start := i
+			for i < len(rs) && (unicode.IsLetter(rs[i]) || unicode.IsDigit(rs[i]) || rs[i] == '.' || rs[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, value: string(rs[start:i])})
+
+		case isIdentifierRune(r):
+			start := i
+			for i < len(rs) && (isIdentifierRune(rs[i]) || unicode.IsDigit(rs[i])) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenWord, value: string(rs[start:i])})
+
+		default:
+			value := string(r)
+			if i+1 < len(rs) {
+				for _, s := range twoCharSymbols {
+					if string(rs[i:i+2]) == s {
+						value = s
+						break
+					}
+				}
+			}
+			tokens = append(tokens, token{kind: tokenSymbol, value: value})
+			i += len([]rune(value))
+		}
+	}
+
+	return tokens
+}
+
+// scanQuoted returns the index right after the closing quote of the quoted text starts at `start`.
+// Both backslash escaping and doubled quotes are supported.
+func scanQuoted(rs []rune, start int) int {
+	quote := rs[start]
+	i := start + 1
+	for i < len(rs) {
+		switch {
+		case rs[i] == '\\':
+			i += 2
+		case rs[i] == quote && i+1 < len(rs) && rs[i+1] == quote:
+			i += 2
+		case rs[i] == quote:
+			return i + 1
+		default:
+			i++
+		}
+	}
+	return len(rs)
+}
+
+func isIdentifierRune(r rune) bool {
+	return r == '_' || r == '$' || unicode.IsLetter(r)
+}
+
+func isPlainIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		if !(r == '_' || (r < unicode.MaxASCII && unicode.IsLetter(r)) || (i > 0 && r < unicode.MaxASCII && unicode.IsDigit(r))) {
+			return false
+		}
+	}
+	return true
+}
+
+// isKeyword reports whether the word may be a keyword, quotes around such words are kept.
+func isKeyword(s string) bool {
+	lower := strings.ToLower(s)
+	_, ok := keywords[lower]
+	if !ok {
+		_, ok = contextualKeywords[lower]
+	}
+	return ok
+}
+
+// isKeywordAt reports whether the word at `i` is a keyword in its position.
+func isKeywordAt(tokens []token, i int) bool {
+	lower := strings.ToLower(tokens[i].value)
+	if _, ok := keywords[lower]; ok {
+		return true
+	}
+	if inPosition, ok := contextualKeywords[lower]; ok {
+		return inPosition(tokenAt(tokens, i-1), tokenAt(tokens, i+1))
+	}
+	return false
+}
+
+// tokenAt returns the token at `i`, or an empty symbol if `i` is out of range.
+func tokenAt(tokens []token, i int) token {
+	if i < 0 || i >= len(tokens) {
+		return token{kind: tokenSymbol}
+	}
+	return tokens[i]
+}
+
+// isIdentifierToken reports whether the token is an identifier, e.g. the name of a stream.
+func isIdentifierToken(t token) bool {
+	if t.kind == tokenQuotedIdentifier {
+		return true
+	}
+	_, reserved := keywords[strings.ToLower(t.value)]
+	return t.kind == tokenWord && !reserved
+}
+
+// keywords are case-insensitive, unlike identifiers and most of the function names. They are lower-cased wherever
+// they appear, thus only words which can't be bare identifiers are listed.
+var keywords = map[string]struct{}{
+	"all": {}, "and": {}, "as": {}, "asc": {}, "between": {}, "by": {}, "case": {}, "cast": {}, "cross": {},
+	"desc": {}, "distinct": {}, "else": {}, "emit": {}, "end": {}, "except": {}, "exists": {}, "false": {},
+	"from": {}, "full": {}, "group": {}, "having": {}, "ilike": {}, "in": {}, "inner": {}, "insert": {},
+	"intersect": {}, "interval": {}, "into": {}, "is": {}, "join": {}, "left": {}, "like": {}, "limit": {},
+	"not": {}, "null": {}, "nulls": {}, "on": {}, "or": {}, "order": {}, "outer": {}, "over": {}, "right": {},
+	"select": {}, "settings": {}, "then": {}, "to": {}, "true": {}, "union": {}, "using": {}, "when": {},
+	"where": {}, "with": {},
+}
+
+// contextualKeywords are the words which are keywords only in certain positions, elsewhere they are identifiers (e.g.
+// a column named `Year`) whose case is significant. The functions report whether the word between `prev` and `next`
+// is in a keyword position.
+var contextualKeywords = map[string]func(prev, next token) bool{
+	// interval units, e.g. INTERVAL 1 DAY
+	"second": isIntervalUnit, "minute": isIntervalUnit, "hour": isIntervalUnit, "day": isIntervalUnit,
+	"week": isIntervalUnit, "month": isIntervalUnit, "quarter": isIntervalUnit, "year": isIntervalUnit,
+
+	// ORDER BY a NULLS FIRST
+	"first": isNullsOrder, "last": isNullsOrder,
+
+	// table functions, e.g. table(s) and changelog(s, id)
+	"table": isFunctionCall, "changelog": isFunctionCall,
+
+	// FROM s FINAL and FROM table(s) FINAL
+	"final": func(prev, _ token) bool { return isIdentifierToken(prev) || prev.value == ")" },
+
+	// FORMAT JSONEachRow
+	"format": func(_, next token) bool { return isIdentifierToken(next) },
+
+	// LIMIT 10 OFFSET 5
+	"offset": func(prev, _ token) bool { return prev.kind == tokenNumber },
+
+	// OVER (PARTITION BY a)
+	"partition": isFollowedBy("by"),
+
+	// EMIT PERIODIC 5s and EMIT AFTER WATERMARK AND DELAY 2s
+	"periodic": isFollowedByNumber, "delay": isFollowedByNumber,
+	"after":     func(prev, _ token) bool { return strings.EqualFold(prev.value, "emit") },
+	"watermark": func(prev, _ token) bool { return strings.EqualFold(prev.value, "after") },
+
+	// join modifiers, e.g. LEFT ASOF JOIN and ARRAY JOIN
+	"any": isJoinModifier, "anti": isJoinModifier, "array": isJoinModifier, "asof": isJoinModifier,
+	"global": isJoinModifier, "semi": isJoinModifier,
+
+	// INSERT INTO s (a, b) VALUES (1, 2)
+	"values": func(prev, next token) bool {
+		return (isIdentifierToken(prev) || prev.value == ")") && next.value == "("
+	},
+}
+
+func isIntervalUnit(prev, _ token) bool {
+	return prev.kind == tokenNumber || prev.kind == tokenString
+}
+
+func isFollowedByNumber(_, next token) bool {
+	return next.kind == tokenNumber
+}
+
+func isFollowedBy(word string) func(prev, next token) bool {
+	return func(_, next token) bool {
+		return next.kind == tokenWord && strings.EqualFold(next.value, word)
+	}
+}
+
+func isJoinModifier(_, next token) bool {
+	if next.kind != tokenWord {
+		return false
+	}
+	switch strings.ToLower(next.value) {
+	case "join", "all", "any", "anti", "asof", "inner", "left", "right", "full", "outer", "semi":
+		return true
+	}
+	return false
+}
+
+func isNullsOrder(prev, _ token) bool {
+	return strings.EqualFold(prev.value, "nulls")
+}
+
+func isFunctionCall(_, next token) bool {
+	return next.kind == tokenSymbol && next.value == "("
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package sqlnorm
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	cases := []struct {
+		name string
+		sql  string
+		want string
+	}{
+		{
+			name: "whitespaces",
+			sql:  "select  a,\n\tb\nfrom   s  ",
+			want: "select a , b from s",
+		},
+		{
+			name: "keyword case",
+			sql:  "SELECT a FROM s WHERE a > 1 GROUP BY a",
+			want: "select a from s where a > 1 group by a",
+		},
+		{
+			name: "identifier case is kept",
+			sql:  "select MyColumn, count() from MyStream",
+			want: "select MyColumn , count ( ) from MyStream",
+		},
+		{
+			name: "comments",
+			sql:  "select a -- the a column\nfrom /* the stream */ s",
+			want: "select a from s",
+		},
+		{
+			name: "identifier quoting",
+			sql:  "select `a`, \"b\", `c d`, `select` from `s`",
+			want: "select a , b , `c d` , `select` from s",
+		},
+		{
+			name: "string literals are kept",
+			sql:  "select 'SELECT  --  a' from s where b = 'it''s' or c = 'x\\'y'",
+			want: "select 'SELECT  --  a' from s where b = 'it''s' or c = 'x\\'y'",
+		},
+		{
+			name: "operators",
+			sql:  "select a!=b, a<>b, a>=1, x::int from s",
+			want: "select a != b , a <> b , a >= 1 , x :: int from s",
+		},
+		{
+			name: "trailing semicolons",
+			sql:  "select 1;;",
+			want: "select 1",
+		},
+		{
+			name: "contextual keywords",
+			sql:  "SELECT Year, Day FROM table(S) FINAL WHERE t > now() - INTERVAL 1 YEAR ORDER BY Year NULLS FIRST LIMIT 10 OFFSET 5",
+			want: "select Year , Day from table ( S ) final where t > now ( ) - interval 1 year order by Year nulls first limit 10 offset 5",
+		},
+		{
+			name: "contextual keywords as identifiers",
+			sql:  "SELECT Format, Final, Values, Offset, Delay FROM Changelog",
+			want: "select Format , Final , Values , Offset , Delay from Changelog",
+		},
+		{
+			name: "emit and joins",
+			sql:  "SELECT * FROM a LEFT ASOF JOIN b ON a.k = b.k EMIT AFTER WATERMARK AND DELAY 2s",
+			want: "select * from a left asof join b on a . k = b . k emit after watermark and delay 2s",
+		},
+		{
+			name: "numbers",
+			sql:  "select 1.5, 10_000, 0x1F",
+			want: "select 1.5 , 10_000 , 0x1F",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Normalize(c.sql); got != c.want {
+				t.Errorf("Normalize(%q) = %q, want %q", c.sql, got, c.want)
+			}
+		})
+	}
+}
+
+func TestEqual(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want bool
+	}{
+		{"select * from s", "SELECT *\nFROM `s`;", true},
+		{"select a from s where x > 1", "select a from s where x>1", true},
+		{"select a from s", "select A from s", false},
+		{"SELECT Year FROM t", "SELECT year FROM t", false},
+		{"select max(Day) from t", "select max(day) from t", false},
+		{"select 1 from t where x > INTERVAL 1 DAY", "select 1 from t where x > interval 1 day", true},
+		{"select 'a' from s", "select 'A' from s", false},
+		{"select a from s", "select a from t", false},
+	}
+
+	for _, c := range cases {
+		if got := Equal(c.a, c.b); got != c.want {
+			t.Errorf("Equal(%q, %q) = %v, want %v", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestReferences(t *testing.T) {
+	cases := []struct {
+		sql  string
+		want bool
+	}{
+		{"select * from cities", true},
+		{"SELECT count() FROM `cities` WHERE x > 1", true},
+		{"select * from default.cities", true},
+		{"select * from table(\"cities\")", true},
+		{"select * from Cities", false},
+		{"select * from cities_v2", false},
+		{"select 'cities' from s -- cities", false},
+	}
+
+	for _, c := range cases {
+		if got := References(c.sql, "cities"); got != c.want {
+			t.Errorf("References(%q, cities) = %v, want %v", c.sql, got, c.want)
+		}
+	}
+}
@@ -5,6 +5,7 @@ package timeplus
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -16,6 +17,21 @@ type resource interface {
 	resourceID() string
 }
 
+// labeled is implemented by resources with labels. Labels are read-only, they are managed outside of the provider (e.g.
+// in the console), so they are never sent in requests, otherwise a full update (i.e. PUT) would overwrite them.
+type labeled interface {
+	withoutLabels() any
+}
+
+// requestBody encodes the resource to the request body, without the read-only labels
+func requestBody(res resource) ([]byte, error) {
+	var v any = res
+	if l, ok := res.(labeled); ok {
+		v = l.withoutLabels()
+	}
+	return json.Marshal(v)
+}
+
 type Client struct {
 	*http.Client
 
@@ -66,7 +82,7 @@ func (c *Client) get(res resource) error {
 }
 
 func (c *Client) post(res resource) error {
-	payload, err := json.Marshal(res)
+	payload, err := requestBody(res)
 	if err != nil {
 		return fmt.Errorf("unable to encode request body: %w", err)
 	}
@@ -79,7 +95,7 @@ func (c *Client) post(res resource) error {
 }
 
 func (c *Client) put(res resource) error {
-	payload, err := json.Marshal(res)
+	payload, err := requestBody(res)
 	if err != nil {
 		return fmt.Errorf("unable to encode request body: %w", err)
 	}
@@ -92,7 +108,7 @@ func (c *Client) put(res resource) error {
 }
 
 func (c *Client) patch(res resource) error {
-	payload, err := json.Marshal(res)
+	payload, err := requestBody(res)
 	if err != nil {
 		return fmt.Errorf("unable to encode request body: %w", err)
 	}
@@ -112,6 +128,33 @@ func (c *Client) delete(res resource) error {
 	return c.do(req, nil)
 }
 
+// list fetches all resources of the same kind as `res` into `objs`, e.g. `GET sinks`
+func (c *Client) list(res resource, objs any) error {
+	req, err := c.newRequest(http.MethodGet, c.baseURL.JoinPath(res.resourcePath()).String(), nil)
+	if err != nil {
+		return fmt.Errorf("unable to create request: %w", err)
+	}
+	return c.do(req, objs)
+}
+
+// describe fetches the description of the resource, e.g. `GET views/<name>/describe`
+func (c *Client) describe(res resource, obj any) error {
+	req, err := c.newRequest(http.MethodGet, c.baseURL.JoinPath(res.resourcePath(), res.resourceID(), "describe").String(), nil)
+	if err != nil {
+		return fmt.Errorf("unable to create request: %w", err)
+	}
+	return c.do(req, obj)
+}
+
+// action triggers an action of the resource, e.g. `POST views/<name>/stop`
+func (c *Client) action(res resource, action string) error {
+	req, err := c.newRequest(http.MethodPost, c.baseURL.JoinPath(res.resourcePath(), res.resourceID(), action).String(), nil)
+	if err != nil {
+		return fmt.Errorf("unable to create request: %w", err)
+	}
+	return c.do(req, nil)
+}
+
 func (c *Client) newRequest(method, url string, body io.Reader) (*http.Request, error) {
 	req, err := http.NewRequest(method, url, body)
 	if err != nil {
@@ -134,7 +177,7 @@ func (c *Client) do(req *http.Request, obj any) error {
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		return fmt.Errorf("request failed: statusCode=%d body='%s'", resp.StatusCode, string(bodyBytes))
+		return &ResponseError{StatusCode: resp.StatusCode, Body: string(bodyBytes)}
 	}
 
 	if obj != nil {
@@ -145,3 +188,19 @@ func (c *Client) do(req *http.Request, obj any) error {
 
 	return nil
 }
+
+// ResponseError is returned when the server responds with a non-2xx status code.
+type ResponseError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("request failed: statusCode=%d body='%s'", e.StatusCode, e.Body)
+}
+
+// IsNotFound reports whether the error is caused by requesting an object which does not exist.
+func IsNotFound(err error) bool {
+	var respErr *ResponseError
+	return errors.As(err, &respErr) && respErr.StatusCode == http.StatusNotFound
+}
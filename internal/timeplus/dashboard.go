@@ -28,6 +28,15 @@ type Dashboard struct {
 	Name        string  `json:"name"`
 	Description string  `json:"description"`
 	Panels      []Panel `json:"panels"`
+
+	// The key-value labels attached to the dashboard, they can be used to filter dashboards when listing them. It's read-only, it's never sent in requests.
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// withoutLabels implements labeled
+func (s Dashboard) withoutLabels() any {
+	s.Labels = nil
+	return s
 }
 
 // redashboardID implements redashboard
@@ -57,3 +66,10 @@ func (c *Client) GetDashboard(id string) (Dashboard, error) {
 	err := c.get(&s)
 	return s, err
 }
+
+// ListDashboards returns all dashboards.
+func (c *Client) ListDashboards() ([]Dashboard, error) {
+	var l []Dashboard
+	err := c.list(Dashboard{}, &l)
+	return l, err
+}
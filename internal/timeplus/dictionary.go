@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package timeplus
+
+import (
+	"errors"
+	"slices"
+)
+
+type DictionarySourceType string
+
+const (
+	DictionarySourceStream        DictionarySourceType = "stream"
+	DictionarySourceMutableStream DictionarySourceType = "mutable_stream"
+	DictionarySourceClickHouse    DictionarySourceType = "clickhouse"
+	DictionarySourceHTTP          DictionarySourceType = "http"
+)
+
+func DictionarySourceTypeFrom(s string) (DictionarySourceType, error) {
+	t := DictionarySourceType(s)
+
+	if slices.Contains([]DictionarySourceType{
+		DictionarySourceStream,
+		DictionarySourceMutableStream,
+		DictionarySourceClickHouse,
+		DictionarySourceHTTP,
+	}, t) {
+		return t, nil
+	}
+
+	return "", errors.New("unknown dictionary source type")
+}
+
+type DictionaryLayout string
+
+const (
+	DictionaryLayoutFlat                  DictionaryLayout = "flat"
+	DictionaryLayoutHashed                DictionaryLayout = "hashed"
+	DictionaryLayoutSparseHashed          DictionaryLayout = "sparse_hashed"
+	DictionaryLayoutComplexKeyHashed      DictionaryLayout = "complex_key_hashed"
+	DictionaryLayoutRangeHashed           DictionaryLayout = "range_hashed"
+	DictionaryLayoutComplexKeyRangeHashed DictionaryLayout = "complex_key_range_hashed"
+	DictionaryLayoutCache                 DictionaryLayout = "cache"
+	DictionaryLayoutComplexKeyCache       DictionaryLayout = "complex_key_cache"
+	DictionaryLayoutDirect                DictionaryLayout = "direct"
+	DictionaryLayoutComplexKeyDirect      DictionaryLayout = "complex_key_direct"
+	DictionaryLayoutIPTrie                DictionaryLayout = "ip_trie"
+)
+
+func DictionaryLayoutFrom(s string) (DictionaryLayout, error) {
+	l := DictionaryLayout(s)
+	if l == "" {
+		l = DictionaryLayoutHashed
+	}
+
+	if slices.Contains([]DictionaryLayout{
+		DictionaryLayoutFlat,
+		DictionaryLayoutHashed,
+		DictionaryLayoutSparseHashed,
+		DictionaryLayoutComplexKeyHashed,
+		DictionaryLayoutRangeHashed,
+		DictionaryLayoutComplexKeyRangeHashed,
+		DictionaryLayoutCache,
+		DictionaryLayoutComplexKeyCache,
+		DictionaryLayoutDirect,
+		DictionaryLayoutComplexKeyDirect,
+		DictionaryLayoutIPTrie,
+	}, l) {
+		return l, nil
+	}
+
+	return "", errors.New("unknown dictionary layout")
+}
+
+// IsRange returns true if the layout requires the range columns
+func (l DictionaryLayout) IsRange() bool {
+	return l == DictionaryLayoutRangeHashed || l == DictionaryLayoutComplexKeyRangeHashed
+}
+
+type DictionaryColumn struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Default string `json:"default,omitempty"`
+}
+
+type DictionaryHTTPSource struct {
+	URL string `json:"url"`
+	// The format of the HTTP response body, e.g. `JSONEachRow`, `CSVWithNames`
+	Format string `json:"format"`
+}
+
+type DictionarySource struct {
+	Type DictionarySourceType `json:"type"`
+
+	// Only valid when `type` is `stream` or `mutable_stream`.
+	Stream string `json:"stream,omitempty"`
+
+	// Only valid when `type` is `clickhouse`.
+	ClickHouse *ExternalTableSettings `json:"clickhouse,omitempty"`
+
+	// Only valid when `type` is `http`.
+	HTTP *DictionaryHTTPSource `json:"http,omitempty"`
+}
+
+type Dictionary struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+
+	// The columns which make up the dictionary key
+	Keys []DictionaryColumn `json:"keys,omitempty"`
+
+	// The columns which can be looked up with `dict_get`
+	Attributes []DictionaryColumn `json:"attributes,omitempty"`
+
+	Source *DictionarySource `json:"source,omitempty"`
+	Layout DictionaryLayout  `json:"layout,omitempty"`
+
+	// Only valid for `range_hashed` and `complex_key_range_hashed` layouts, the columns define the range of each row.
+	RangeMin string `json:"range_min,omitempty"`
+	RangeMax string `json:"range_max,omitempty"`
+
+	// The dictionary is reloaded at a random time between `LifetimeMin` and `LifetimeMax` seconds. 0 means never reload.
+	LifetimeMin int `json:"lifetime_min,omitempty"`
+	LifetimeMax int `json:"lifetime_max,omitempty"`
+}
+
+// resourceID implements resource
+func (d Dictionary) resourceID() string {
+	return d.Name
+}
+
+// resourcePath implements resource
+func (Dictionary) resourcePath() string {
+	return "dictionaries"
+}
+
+func (c *Client) CreateDictionary(d *Dictionary) error {
+	return c.post(d)
+}
+
+func (c *Client) DeleteDictionary(d *Dictionary) error {
+	return c.delete(d)
+}
+
+func (c *Client) UpdateDictionary(d *Dictionary) error {
+	return c.patch(d)
+}
+
+func (c *Client) GetDictionary(name string) (Dictionary, error) {
+	d := Dictionary{Name: name}
+	err := c.get(&d)
+	return d, err
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package timeplus
+
+import (
+	"errors"
+	"slices"
+)
+
+type ExternalTableType string
+
+const (
+	ExternalTableTypeClickHouse ExternalTableType = "clickhouse"
+	ExternalTableTypeMySQL      ExternalTableType = "mysql"
+	ExternalTableTypePostgreSQL ExternalTableType = "postgresql"
+)
+
+func ExternalTableTypeFrom(s string) (ExternalTableType, error) {
+	t := ExternalTableType(s)
+
+	if slices.Contains([]ExternalTableType{
+		ExternalTableTypeClickHouse,
+		ExternalTableTypeMySQL,
+		ExternalTableTypePostgreSQL,
+	}, t) {
+		return t, nil
+	}
+
+	return "", errors.New("unknown external table type")
+}
+
+// ExternalTableSettings describes how to connect to the remote table. All the supported engines share the same settings.
+type ExternalTableSettings struct {
+	// The address of the remote database server, in the `host:port` format
+	Address  string `json:"address"`
+	Database string `json:"database,omitempty"`
+	Table    string `json:"table"`
+	User     string `json:"user,omitempty"`
+
+	// The API does not return the password
+	Password string `json:"password,omitempty"`
+
+	// Whether to use TLS to connect to the remote database server
+	Secure bool `json:"secure,omitempty"`
+}
+
+type ExternalTable struct {
+	Name        string                `json:"name"`
+	Description string                `json:"description"`
+	Type        ExternalTableType     `json:"type"`
+	Settings    ExternalTableSettings `json:"settings"`
+}
+
+// resourceID implements resource
+func (t ExternalTable) resourceID() string {
+	return t.Name
+}
+
+// resourcePath implements resource
+func (ExternalTable) resourcePath() string {
+	return "external_tables"
+}
+
+func (c *Client) CreateExternalTable(t *ExternalTable) error {
+	return c.post(t)
+}
+
+func (c *Client) DeleteExternalTable(t *ExternalTable) error {
+	return c.delete(t)
+}
+
+func (c *Client) UpdateExternalTable(t *ExternalTable) error {
+	return c.put(t)
+}
+
+func (c *Client) GetExternalTable(name string) (ExternalTable, error) {
+	t := ExternalTable{Name: name}
+	err := c.get(&t)
+	return t, err
+}
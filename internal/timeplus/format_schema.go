@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package timeplus
+
+import (
+	"errors"
+	"slices"
+)
+
+type FormatSchemaType string
+
+const (
+	FormatSchemaTypeProtobuf FormatSchemaType = "protobuf"
+	FormatSchemaTypeAvro     FormatSchemaType = "avro"
+)
+
+func FormatSchemaTypeFrom(s string) (FormatSchemaType, error) {
+	t := FormatSchemaType(s)
+
+	if slices.Contains([]FormatSchemaType{
+		FormatSchemaTypeProtobuf,
+		FormatSchemaTypeAvro,
+	}, t) {
+		return t, nil
+	}
+
+	return "", errors.New("unknown format schema type")
+}
+
+// FormatSchema is a Protobuf or Avro schema registered in Timeplus, external streams and sinks refer to it by name via the `format_schema` property.
+type FormatSchema struct {
+	Name string           `json:"name"`
+	Type FormatSchemaType `json:"type"`
+
+	// The content of the `.proto` file or the Avro schema JSON
+	Body string `json:"body"`
+}
+
+// resourceID implements resource
+func (s FormatSchema) resourceID() string {
+	return s.Name
+}
+
+// resourcePath implements resource
+func (FormatSchema) resourcePath() string {
+	return "schemas"
+}
+
+func (c *Client) CreateFormatSchema(s *FormatSchema) error {
+	return c.post(s)
+}
+
+func (c *Client) DeleteFormatSchema(s *FormatSchema) error {
+	return c.delete(s)
+}
+
+func (c *Client) GetFormatSchema(name string) (FormatSchema, error) {
+	s := FormatSchema{Name: name}
+	err := c.get(&s)
+	return s, err
+}
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package timeplus
+
+import "fmt"
+
+// Object types returned by the dependency API
+const (
+	ObjectTypeStream           = "stream"
+	ObjectTypeExternalStream   = "external_stream"
+	ObjectTypeView             = "view"
+	ObjectTypeMaterializedView = "materialized_view"
+	ObjectTypeDictionary       = "dictionary"
+	ObjectTypeExternalTable    = "external_table"
+	ObjectTypeFunction         = "function"
+	ObjectTypeSink             = "sink"
+	ObjectTypeSource           = "source"
+)
+
+// ObjectTypeDashboard is not returned by the dependency API, dashboards are found by the queries of their panels.
+const ObjectTypeDashboard = "dashboard"
+
+// ObjectRef refers to an object in Timeplus. ID is only set for objects that are identified by ID, i.e. sinks, sources
+// and dashboards.
+type ObjectRef struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+	ID   string `json:"id,omitempty"`
+}
+
+// Dependencies of an object. Upstreams are the objects it reads from, downstreams are the objects read from it.
+type Dependencies struct {
+	Name        string      `json:"name"`
+	Upstreams   []ObjectRef `json:"upstreams"`
+	Downstreams []ObjectRef `json:"downstreams"`
+}
+
+// resourceID implements resource
+func (d Dependencies) resourceID() string {
+	return d.Name
+}
+
+// resourcePath implements resource
+func (Dependencies) resourcePath() string {
+	return "dependencies"
+}
+
+// GetDependencies returns the direct upstream and downstream objects of the object with the given name.
+func (c *Client) GetDependencies(name string) (Dependencies, error) {
+	d := Dependencies{Name: name}
+	err := c.get(&d)
+	return d, err
+}
+
+// DeleteObject deletes the object referred by obj.
+func (c *Client) DeleteObject(obj ObjectRef) error {
+	switch obj.Type {
+	case ObjectTypeStream, ObjectTypeExternalStream:
+		return c.DeleteStream(&Stream{Name: obj.Name})
+	case ObjectTypeView:
+		return c.DeleteView(&View{Name: obj.Name})
+	case ObjectTypeMaterializedView:
+		return c.DeleteMaterializedView(&MaterializedView{View: View{Name: obj.Name}})
+	case ObjectTypeDictionary:
+		return c.DeleteDictionary(&Dictionary{Name: obj.Name})
+	case ObjectTypeExternalTable:
+		return c.DeleteExternalTable(&ExternalTable{Name: obj.Name})
+	case ObjectTypeFunction:
+		return c.DeleteUDF(obj.Name)
+	case ObjectTypeSink:
+		return c.DeleteSink(&Sink{ID: obj.ID})
+	case ObjectTypeSource:
+		return c.DeleteSource(&Source{ID: obj.ID})
+	}
+	return fmt.Errorf("unknown object type %q", obj.Type)
+}
+
+// ObjectDefinition is the full definition of an object, it's read before the object is deleted, so that the object
+// can be recreated later.
+type ObjectDefinition struct {
+	Ref ObjectRef
+
+	def any
+}
+
+// GetObjectDefinition reads the full definition of the object referred by obj.
+func (c *Client) GetObjectDefinition(obj ObjectRef) (ObjectDefinition, error) {
+	d := ObjectDefinition{Ref: obj}
+
+	var err error
+	switch obj.Type {
+	case ObjectTypeStream, ObjectTypeExternalStream:
+		var s Stream
+		s, err = c.GetStream(obj.Name)
+		d.def = &s
+	case ObjectTypeView:
+		var v View
+		v, err = c.GetView(obj.Name)
+		d.def = &v
+	case ObjectTypeMaterializedView:
+		var v MaterializedView
+		v, err = c.GetMaterializedView(obj.Name)
+		d.def = &v
+	case ObjectTypeDictionary:
+		var dict Dictionary
+		dict, err = c.GetDictionary(obj.Name)
+		d.def = &dict
+	case ObjectTypeExternalTable:
+		var t ExternalTable
+		t, err = c.GetExternalTable(obj.Name)
+		d.def = &t
+	case ObjectTypeFunction:
+		var u UDF
+		u, err = c.GetUDF(obj.Name)
+		d.def = &u
+	case ObjectTypeSink:
+		var s Sink
+		s, err = c.GetSink(obj.ID)
+		d.def = &s
+	case ObjectTypeSource:
+		var s Source
+		s, err = c.GetSource(obj.ID)
+		d.def = &s
+	default:
+		err = fmt.Errorf("unknown object type %q", obj.Type)
+	}
+	return d, err
+}
+
+// RecreateObject creates the object from its definition, and returns the reference to the created object. Sinks and
+// sources are identified by IDs, the ID of the created one can be different from the ID in the definition.
+func (c *Client) RecreateObject(d ObjectDefinition) (ObjectRef, error) {
+	ref := d.Ref
+
+	var err error
+	switch def := d.def.(type) {
+	case *Stream:
+		err = c.CreateStream(def)
+	case *View:
+		err = c.CreateView(def)
+	case *MaterializedView:
+		err = c.CreateMaterializedView(def)
+	case *Dictionary:
+		err = c.CreateDictionary(def)
+	case *ExternalTable:
+		err = c.CreateExternalTable(def)
+	case *UDF:
+		err = c.CreateUDF(def)
+	case *Sink:
+		err = c.CreateSink(def)
+		ref.ID = def.ID
+	case *Source:
+		err = c.CreateSource(def)
+		ref.ID = def.ID
+	default:
+		err = fmt.Errorf("unknown definition of %s %q", ref.Type, ref.Name)
+	}
+	return ref, err
+}
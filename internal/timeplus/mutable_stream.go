@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package timeplus
+
+// MutableStreamIndex describes a secondary index of a mutable stream.
+type MutableStreamIndex struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+	// Whether the index only accepts unique values
+	Unique bool `json:"unique,omitempty"`
+}
+
+// MutableStreamFamily describes a column family of a mutable stream. Columns in the same family are stored together.
+type MutableStreamFamily struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+}
+
+type MutableStream struct {
+	// Stream name should only contain a maximum of 64 letters, numbers, or _, and start with a letter
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Columns     []Column `json:"columns"`
+
+	// The columns which make up the primary key, rows with the same primary key are upserted
+	PrimaryKey []string `json:"primary_key"`
+
+	Indexes  []MutableStreamIndex  `json:"indexes,omitempty"`
+	Families []MutableStreamFamily `json:"families,omitempty"`
+
+	Shards            int `json:"shards,omitempty"`
+	ReplicationFactor int `json:"replication_factor,omitempty"`
+
+	// The column used to decide which row wins when two rows with the same primary key are upserted
+	VersionColumn string `json:"version_column,omitempty"`
+
+	// The max size a stream can grow. Any non-positive value means unlimited size. Default to 10 GiB.
+	RetentionBytes int `json:"logstore_retention_bytes,omitempty" example:"10737418240"`
+
+	// The max time the data can be retained in the stream. Any non-positive value means unlimited time. Default to 7 days.
+	RetentionMS int `json:"logstore_retention_ms,omitempty" example:"604800000"`
+}
+
+// resourceID implements resource
+func (s MutableStream) resourceID() string {
+	return s.Name
+}
+
+// resourcePath implements resource
+func (MutableStream) resourcePath() string {
+	return "mutable_streams"
+}
+
+func (c *Client) CreateMutableStream(s *MutableStream) error {
+	return c.post(s)
+}
+
+func (c *Client) DeleteMutableStream(s *MutableStream) error {
+	return c.delete(s)
+}
+
+func (c *Client) UpdateMutableStream(s *MutableStream) error {
+	return c.patch(s)
+}
+
+func (c *Client) GetMutableStream(name string) (MutableStream, error) {
+	s := MutableStream{Name: name}
+	err := c.get(&s)
+	return s, err
+}
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package timeplus
+
+type RandomStream struct {
+	// Stream name should only contain a maximum of 64 letters, numbers, or _, and start with a letter
+	Name        string `json:"name"`
+	Description string `json:"description"`
+
+	// The `default` expression of each column is used to generate the column values, e.g. `rand() % 100`
+	Columns []Column `json:"columns"`
+
+	// This column will be used as the event time if specified
+	EventTimeColumn string `json:"event_time_column,omitempty"`
+
+	// How many events are generated per second, fractional values like `0.5` are allowed. Default to 1000.
+	EventsPerSecond float64 `json:"eps,omitempty" example:"1000"`
+}
+
+// resourceID implements resource
+func (s RandomStream) resourceID() string {
+	return s.Name
+}
+
+// resourcePath implements resource
+func (RandomStream) resourcePath() string {
+	return "random_streams"
+}
+
+func (c *Client) CreateRandomStream(s *RandomStream) error {
+	return c.post(s)
+}
+
+func (c *Client) DeleteRandomStream(s *RandomStream) error {
+	return c.delete(s)
+}
+
+func (c *Client) UpdateRandomStream(s *RandomStream) error {
+	return c.patch(s)
+}
+
+func (c *Client) GetRandomStream(name string) (RandomStream, error) {
+	s := RandomStream{Name: name}
+	err := c.get(&s)
+	return s, err
+}
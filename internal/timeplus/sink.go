@@ -2,6 +2,23 @@
 
 package timeplus
 
+// Sink and source status
+const (
+	PipelineStatusRunning = "running"
+	PipelineStatusPaused  = "paused"
+	PipelineStatusError   = "error"
+)
+
+// PipelineStats are the throughput counters of a sink or source since it's started.
+type PipelineStats struct {
+	// the number of records sent by the sink, or ingested by the source
+	Records int64 `json:"records"`
+	// the number of bytes sent by the sink, or ingested by the source
+	Bytes int64 `json:"bytes"`
+	// the number of records failed to be sent or ingested
+	FailedRecords int64 `json:"failed_records"`
+}
+
 type Sink struct {
 	ID          string `json:"id"`
 	Name        string `json:"name"`
@@ -13,6 +30,19 @@ type Sink struct {
 
 	// Additional properties that required to write the data to the sink (e.g. broker url). Please refer to the sinks documentation
 	Properties map[string]any `json:"properties"`
+
+	// read-only fields
+	Status    string         `json:"status,omitempty"`
+	LastError string         `json:"last_error,omitempty"`
+	Stats     *PipelineStats `json:"stats,omitempty"`
+	// The key-value labels attached to the sink, they can be used to filter sinks when listing them. It's read-only, it's never sent in requests.
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// withoutLabels implements labeled
+func (s Sink) withoutLabels() any {
+	s.Labels = nil
+	return s
 }
 
 // resourceID implements resource
@@ -42,3 +72,20 @@ func (c *Client) GetSink(id string) (Sink, error) {
 	err := c.get(&s)
 	return s, err
 }
+
+// ListSinks returns all sinks.
+func (c *Client) ListSinks() ([]Sink, error) {
+	var l []Sink
+	err := c.list(Sink{}, &l)
+	return l, err
+}
+
+// StopSink pauses the sink, it stops sending data until it's started again.
+func (c *Client) StopSink(id string) error {
+	return c.action(&Sink{ID: id}, "stop")
+}
+
+// StartSink resumes a paused sink.
+func (c *Client) StartSink(id string) error {
+	return c.action(&Sink{ID: id}, "start")
+}
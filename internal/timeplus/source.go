@@ -9,6 +9,19 @@ type Source struct {
 	Stream      string         `json:"stream"`
 	Type        string         `json:"type"`
 	Properties  map[string]any `json:"properties"`
+
+	// read-only fields
+	Status    string         `json:"status,omitempty"`
+	LastError string         `json:"last_error,omitempty"`
+	Stats     *PipelineStats `json:"stats,omitempty"`
+	// The key-value labels attached to the source, they can be used to filter sources when listing them. It's read-only, it's never sent in requests.
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// withoutLabels implements labeled
+func (s Source) withoutLabels() any {
+	s.Labels = nil
+	return s
 }
 
 // resourceID implements resource
@@ -38,3 +51,50 @@ func (c *Client) GetSource(id string) (Source, error) {
 	err := c.get(&s)
 	return s, err
 }
+
+// ListSources returns all sources.
+func (c *Client) ListSources() ([]Source, error) {
+	var l []Source
+	err := c.list(Source{}, &l)
+	return l, err
+}
+
+// StopSource pauses the source, it stops ingesting data until it's started again.
+func (c *Client) StopSource(id string) error {
+	return c.action(&Source{ID: id}, "stop")
+}
+
+// StartSource resumes a paused source.
+func (c *Client) StartSource(id string) error {
+	return c.action(&Source{ID: id}, "start")
+}
+
+// SourcePreview reads a few messages with the configurations of a source without creating it, it's used to check
+// the connectivity and the data format before the source is created.
+type SourcePreview struct {
+	Type       string         `json:"type"`
+	Properties map[string]any `json:"properties"`
+
+	// read-only fields
+	// the messages which are successfully decoded
+	Samples []map[string]any `json:"samples,omitempty"`
+	// the errors encountered while reading or decoding the messages
+	Errors []string `json:"errors,omitempty"`
+}
+
+// resourceID implements resource
+func (SourcePreview) resourceID() string {
+	return ""
+}
+
+// resourcePath implements resource
+func (SourcePreview) resourcePath() string {
+	return "sources/preview"
+}
+
+// PreviewSource reads sample messages with the type and properties of the source.
+func (c *Client) PreviewSource(s *Source) (SourcePreview, error) {
+	p := SourcePreview{Type: s.Type, Properties: s.Properties}
+	err := c.post(&p)
+	return p, err
+}
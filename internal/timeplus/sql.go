@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package timeplus
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"regexp"
+	"strconv"
+)
+
+// SQLAnalysis is the result of analyzing a query with the server.
+type SQLAnalysis struct {
+	SQL            string `json:"sql"`
+	OriginalQuery  string `json:"original_query,omitempty"`
+	RewrittenQuery string `json:"rewritten_query,omitempty"`
+	QueryType      string `json:"query_type,omitempty"`
+	IsStreaming    bool   `json:"is_streaming,omitempty"`
+	HasAggregation bool   `json:"has_aggr,omitempty"`
+	HasJoin        bool   `json:"has_table_join,omitempty"`
+	HasSubquery    bool   `json:"has_subquery,omitempty"`
+	HasUnion       bool   `json:"has_union,omitempty"`
+
+	// the columns of the query output
+	ResultColumns []Column `json:"result_columns,omitempty"`
+}
+
+// resourceID implements resource
+func (SQLAnalysis) resourceID() string {
+	return ""
+}
+
+// resourcePath implements resource
+func (SQLAnalysis) resourcePath() string {
+	return "sqlanalyzer"
+}
+
+// Error codes of the server which mean the query refers to objects that don't exist (yet).
+const (
+	errCodeUnknownFunction = 46
+	errCodeUnknownTable    = 60
+	errCodeUnknownDatabase = 81
+)
+
+// SQLError is returned by AnalyzeSQL when the server rejects the query.
+type SQLError struct {
+	// the server error code, e.g. 62 for syntax errors, it's 0 when the message does not contain any code
+	Code    int
+	Message string
+
+	// the position of the error in the query, both are 1-based and 0 when unknown
+	Line   int
+	Column int
+}
+
+func (e *SQLError) Error() string {
+	return e.Message
+}
+
+// IsUnknownObject reports whether the query is rejected only because it refers to a stream, view or function which
+// does not exist. It's usually because the object will be created in the same apply.
+func (e *SQLError) IsUnknownObject() bool {
+	switch e.Code {
+	case errCodeUnknownFunction, errCodeUnknownTable, errCodeUnknownDatabase:
+		return true
+	}
+	return false
+}
+
+var (
+	errCodePattern  = regexp.MustCompile(`Code: (\d+)`)
+	positionPattern = regexp.MustCompile(`\(line (\d+), col (\d+)\)`)
+)
+
+func newSQLError(body string) *SQLError {
+	var m struct {
+		Message  string `json:"message"`
+		ErrorMsg string `json:"error_msg"`
+	}
+
+	e := &SQLError{Message: body}
+	if err := json.Unmarshal([]byte(body), &m); err == nil {
+		if m.ErrorMsg != "" {
+			e.Message = m.ErrorMsg
+		} else if m.Message != "" {
+			e.Message = m.Message
+		}
+	}
+
+	if match := errCodePattern.FindStringSubmatch(e.Message); match != nil {
+		e.Code, _ = strconv.Atoi(match[1])
+	}
+	if match := positionPattern.FindStringSubmatch(e.Message); match != nil {
+		e.Line, _ = strconv.Atoi(match[1])
+		e.Column, _ = strconv.Atoi(match[2])
+	}
+
+	return e
+}
+
+// AnalyzeSQL asks the server to analyze the query without running it. When the query is invalid, the returned error is a *SQLError.
+func (c *Client) AnalyzeSQL(sql string) (SQLAnalysis, error) {
+	a := SQLAnalysis{SQL: sql}
+	if err := c.post(&a); err != nil {
+		var respErr *ResponseError
+		if errors.As(err, &respErr) && respErr.StatusCode == http.StatusBadRequest {
+			return a, newSQLError(respErr.Body)
+		}
+		return a, err
+	}
+	return a, nil
+}
@@ -71,6 +71,15 @@ type Stream struct {
 
 	// The max time the data can be retained in the stream. Any non-positive value means unlimited time. Default to 7 days.
 	RetentionMS int `json:"logstore_retention_ms,omitempty" example:"604800000"`
+
+	// The key-value labels attached to the stream, they can be used to filter streams when listing them. It's read-only, it's never sent in requests.
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// withoutLabels implements labeled
+func (s Stream) withoutLabels() any {
+	s.Labels = nil
+	return s
 }
 
 // resourceID implements resource
@@ -90,6 +99,13 @@ func (c *Client) CreateStream(s *Stream) error {
 	return nil
 }
 
+// ListStreams returns all streams.
+func (c *Client) ListStreams() ([]Stream, error) {
+	var l []Stream
+	err := c.list(Stream{}, &l)
+	return l, err
+}
+
 func (c *Client) DeleteStream(s *Stream) error {
 	return c.delete(s)
 }
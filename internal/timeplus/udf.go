@@ -7,6 +7,7 @@ type UDFType string
 const (
 	UDFTypeJavascript UDFType = "javascript"
 	UDFTypeRemote     UDFType = "remote"
+	UDFTypePython     UDFType = "python"
 )
 
 type UDFAuthMethod string
@@ -51,10 +52,22 @@ type UDF struct {
 	// Only valid when `type` is `remote` and `auth_method` is `auth_header`
 	AuthContext *UDFAuthContext `json:"auth_context,omitempty"`
 
-	// Only valid when type is 'javascript'. Whether it is an aggregation function.
+	// Only valid when type is 'javascript' or 'python'. Whether it is an aggregation function.
 	IsAggrFunction bool `json:"is_aggregation,omitempty"`
 
 	Source string `json:"source,omitempty"`
+
+	// Only valid when type is 'python'. The pip requirement specifiers of the packages the function imports, e.g. `numpy==1.26.4`.
+	Requirements []string `json:"requirements,omitempty"`
+
+	// The key-value labels attached to the UDF, they can be used to filter UDFs when listing them. It's read-only, it's never sent in requests.
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// withoutLabels implements labeled
+func (u UDF) withoutLabels() any {
+	u.Labels = nil
+	return u
 }
 
 // resourceID implements resource
@@ -84,3 +97,10 @@ func (c *Client) GetUDF(name string) (UDF, error) {
 	err := c.get(&u)
 	return u, err
 }
+
+// ListUDFs returns all UDFs.
+func (c *Client) ListUDFs() ([]UDF, error) {
+	var l []UDF
+	err := c.list(UDF{}, &l)
+	return l, err
+}
@@ -2,10 +2,21 @@
 
 package timeplus
 
+// ViewParameter is a parameter of a parameterized view, it's referred as `{{name:type}}` in the query.
+type ViewParameter struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Default string `json:"default,omitempty"`
+}
+
 type View struct {
 	Name        string
 	Description string
 	Query       string
+	Parameters  []ViewParameter
+
+	// read-only fields
+	Labels map[string]string
 }
 
 func (v *View) toAPIModel() viewAPIModel {
@@ -18,6 +29,7 @@ func (v *View) toAPIModel() viewAPIModel {
 		Description:  v.Description,
 		Query:        v.Query,
 		Materialized: false,
+		Parameters:   v.Parameters,
 	}
 }
 
@@ -28,6 +40,8 @@ func (v *View) fromAPIModel(m viewAPIModel) {
 	v.Name = m.Name
 	v.Description = m.Description
 	v.Query = m.Query
+	v.Parameters = m.Parameters
+	v.Labels = m.Labels
 }
 
 func (c *Client) CreateView(v *View) error {
@@ -45,6 +59,12 @@ func (c *Client) UpdateView(v *View) error {
 	return c.updateView(&m)
 }
 
+// ReplaceView replaces the query of the view in place (i.e. `CREATE OR REPLACE VIEW`), views and sinks depend on the view are kept.
+func (c *Client) ReplaceView(v *View) error {
+	m := v.toAPIModel()
+	return c.replaceView(&m)
+}
+
 func (c *Client) GetView(name string) (v View, err error) {
 	m := viewAPIModel{Name: name}
 	if err = c.get(&m); err != nil {
@@ -54,6 +74,53 @@ func (c *Client) GetView(name string) (v View, err error) {
 	return
 }
 
+// ListViews returns all views, materialized views are not included.
+func (c *Client) ListViews() ([]View, error) {
+	l, err := c.listViews()
+	if err != nil {
+		return nil, err
+	}
+
+	var views []View
+	for _, m := range l {
+		if !m.Materialized {
+			var v View
+			v.fromAPIModel(m)
+			views = append(views, v)
+		}
+	}
+	return views, nil
+}
+
+// DescribeView returns the output columns of the view or materialized view.
+func (c *Client) DescribeView(name string) ([]Column, error) {
+	var d struct {
+		Columns []Column `json:"columns"`
+	}
+	if err := c.describe(&viewAPIModel{Name: name}, &d); err != nil {
+		return nil, err
+	}
+	return d.Columns, nil
+}
+
+// Materialized view status
+const (
+	MaterializedViewStatusRunning = "running"
+	MaterializedViewStatusPaused  = "paused"
+)
+
+// MaterializedViewSettings are the settings used to create a materialized view, they can't be changed afterwards.
+type MaterializedViewSettings struct {
+	// the interval of checkpoints in seconds
+	CheckpointInterval int `json:"checkpoint_interval,omitempty"`
+	// the relative weight of memory the materialized view can use when the memory is insufficient
+	MemoryWeight int `json:"memory_weight,omitempty"`
+	// where the materialized view starts reading, e.g. `earliest`, `latest` or a timestamp
+	SeekTo string `json:"seek_to,omitempty"`
+	// the node the materialized view prefers to run on in a cluster
+	PreferredExecNode int `json:"preferred_exec_node,omitempty"`
+}
+
 type MaterializedView struct {
 	View
 
@@ -61,6 +128,13 @@ type MaterializedView struct {
 	RetentionBytes int
 	RetentionMS    int
 	TTLExpression  string
+	Settings       *MaterializedViewSettings
+
+	// read-only fields
+	Status    string
+	LastError string
+	// the columns of the target stream, either the explicit `TargetStream` or the implicit one created by the server
+	TargetColumns []Column
 }
 
 func (v *MaterializedView) toAPIModel() viewAPIModel {
@@ -77,6 +151,7 @@ func (v *MaterializedView) toAPIModel() viewAPIModel {
 		TTLExpression:  v.TTLExpression,
 		RetentionBytes: v.RetentionBytes,
 		RetentionMS:    v.RetentionMS,
+		Settings:       v.Settings,
 	}
 }
 
@@ -91,6 +166,11 @@ func (v *MaterializedView) fromAPIModel(m viewAPIModel) {
 	v.TTLExpression = m.TTL
 	v.RetentionBytes = m.RetentionBytes
 	v.RetentionMS = m.RetentionMS
+	v.Settings = m.Settings
+	v.Labels = m.Labels
+	v.Status = m.Status
+	v.LastError = m.LastError
+	v.TargetColumns = m.TargetColumns
 }
 
 func (c *Client) CreateMaterializedView(v *MaterializedView) error {
@@ -116,6 +196,45 @@ func (c *Client) UpdateMaterializedView(v *MaterializedView) error {
 	return nil
 }
 
+// ReplaceMaterializedView replaces the query of the materialized view in place, the target stream and the objects depend on
+// the materialized view are kept. The materialized view resumes from where it stopped with the new query.
+func (c *Client) ReplaceMaterializedView(v *MaterializedView) error {
+	m := v.toAPIModel()
+	if err := c.replaceView(&m); err != nil {
+		return err
+	}
+	v.fromAPIModel(m)
+	return nil
+}
+
+// StopMaterializedView pauses the materialized view, it stops processing data until it's started again.
+func (c *Client) StopMaterializedView(name string) error {
+	return c.action(&viewAPIModel{Name: name}, "stop")
+}
+
+// StartMaterializedView resumes a paused materialized view from its last checkpoint.
+func (c *Client) StartMaterializedView(name string) error {
+	return c.action(&viewAPIModel{Name: name}, "start")
+}
+
+// ListMaterializedViews returns all materialized views.
+func (c *Client) ListMaterializedViews() ([]MaterializedView, error) {
+	l, err := c.listViews()
+	if err != nil {
+		return nil, err
+	}
+
+	var views []MaterializedView
+	for _, m := range l {
+		if m.Materialized {
+			var v MaterializedView
+			v.fromAPIModel(m)
+			views = append(views, v)
+		}
+	}
+	return views, nil
+}
+
 func (c *Client) GetMaterializedView(name string) (v MaterializedView, err error) {
 	m := viewAPIModel{Name: name}
 	if err = c.get(&m); err != nil {
@@ -128,13 +247,31 @@ func (c *Client) GetMaterializedView(name string) (v MaterializedView, err error
 type viewAPIModel struct {
 	Name           string `json:"name"`
 	Description    string `json:"description"`
-	Query          string `json:"query,omitempty"` // can't set query when updating
+	Query          string `json:"query,omitempty"` // PATCH ignores query, use PUT to replace it
 	Materialized   bool   `json:"materialized"`
 	TargetStream   string `json:"target_stream,omitempty"`
 	RetentionBytes int    `json:"logstore_retention_bytes,omitempty"`
 	RetentionMS    int    `json:"logstore_retention_ms,omitempty"`
 	TTLExpression  string `json:"ttl_expression,omitempty"`
 	TTL            string `json:"ttl,omitempty"` // ttl is the field name from API responses
+
+	Parameters []ViewParameter `json:"parameters,omitempty"`
+
+	Settings *MaterializedViewSettings `json:"settings,omitempty"`
+
+	// read-only fields of materialized views
+	Status        string   `json:"status,omitempty"`
+	LastError     string   `json:"last_error,omitempty"`
+	TargetColumns []Column `json:"target_columns,omitempty"`
+
+	// read-only fields
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// withoutLabels implements labeled
+func (v viewAPIModel) withoutLabels() any {
+	v.Labels = nil
+	return v
 }
 
 // resourceID implements resource
@@ -159,6 +296,16 @@ func (c *Client) updateView(v *viewAPIModel) error {
 	return c.patch(v)
 }
 
+func (c *Client) replaceView(v *viewAPIModel) error {
+	return c.put(v)
+}
+
+func (c *Client) listViews() ([]viewAPIModel, error) {
+	var l []viewAPIModel
+	err := c.list(viewAPIModel{}, &l)
+	return l, err
+}
+
 func (c *Client) getView(name string) (viewAPIModel, error) {
 	v := viewAPIModel{Name: name}
 	err := c.get(&v)
@@ -66,3 +66,30 @@ func (jsonArrayOfObject) ValidateString(_ context.Context, req validator.StringR
 		resp.Diagnostics.AddAttributeError(req.Path, "invalid JSON array", err.Error())
 	}
 }
+
+// validator for validating a string is any valid JSON value
+type jsonValue struct{}
+
+func JsonValue() validator.String {
+	return jsonValue{}
+}
+
+// Description implements validator.String
+func (jsonValue) Description(_ context.Context) string {
+	return "validates input should be a valid JSON value"
+}
+
+// MarkdownDescription implements validator.String
+func (j jsonValue) MarkdownDescription(ctx context.Context) string {
+	return j.Description(ctx)
+}
+
+// ValidateString implements validator.String
+func (jsonValue) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if !json.Valid([]byte(req.ConfigValue.ValueString())) {
+		resp.Diagnostics.AddAttributeError(req.Path, "invalid JSON value", "the value is not valid JSON")
+	}
+}
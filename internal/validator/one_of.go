@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package validator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// validator for validating the input is one of the allowed values
+type oneOf struct {
+	values []string
+}
+
+func OneOf(values ...string) validator.String {
+	return oneOf{values: values}
+}
+
+// Description implements validator.String
+func (v oneOf) Description(_ context.Context) string {
+	return fmt.Sprintf("validates input should be one of: %s", strings.Join(v.values, ", "))
+}
+
+// MarkdownDescription implements validator.String
+func (v oneOf) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString implements validator.String
+func (v oneOf) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !slices.Contains(v.values, req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(req.Path, "invalid value", fmt.Sprintf("%q is not one of: %s", req.ConfigValue.ValueString(), strings.Join(v.values, ", ")))
+	}
+}
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOneOf(t *testing.T) {
+	cases := []struct {
+		name    string
+		value   types.String
+		wantErr bool
+	}{
+		{name: "allowed", value: types.StringValue("PUT")},
+		{name: "not allowed", value: types.StringValue("GET"), wantErr: true},
+		{name: "case sensitive", value: types.StringValue("put"), wantErr: true},
+		{name: "empty", value: types.StringValue(""), wantErr: true},
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+	}
+
+	v := OneOf("POST", "PUT", "PATCH")
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("http_method"), ConfigValue: c.value}
+			resp := &validator.StringResponse{}
+			v.ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != c.wantErr {
+				t.Errorf("ValidateString(%s) diags = %v, want error %v", c.value, resp.Diagnostics, c.wantErr)
+			}
+		})
+	}
+}